@@ -0,0 +1,212 @@
+package hytek
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// openAge is the upper age from which an age group is treated as open ended.
+const openAge = 99
+
+type AgeGroup struct {
+	MinAge int
+	MaxAge int
+}
+
+func (a AgeGroup) Contains(age int) bool {
+	return age >= a.MinAge && (a.MaxAge == 0 || age <= a.MaxAge)
+}
+
+// Open reports whether the age group has no upper age. HYV files give open
+// age groups an upper age of 99 where ParseAgeGroups leaves it 0.
+func (a AgeGroup) Open() bool {
+	return a.MaxAge == 0 || a.MaxAge >= openAge
+}
+
+// Normalized returns the age group with an open upper age of 0, so open age
+// groups compare equal however they were written.
+func (a AgeGroup) Normalized() AgeGroup {
+	if a.Open() {
+		a.MaxAge = 0
+	}
+	return a
+}
+
+func (a AgeGroup) String() string {
+	switch {
+	case a.Open():
+		return fmt.Sprintf("%v+", a.MinAge)
+	case a.MinAge == 0:
+		return fmt.Sprintf("%v & under", a.MaxAge)
+	case a.MinAge == a.MaxAge:
+		return fmt.Sprint(a.MinAge)
+	}
+	return fmt.Sprintf("%v-%v", a.MinAge, a.MaxAge)
+}
+
+// ParseAgeGroups parses a comma separated list of age groups such as
+// "9-10,11-12,13+".
+func ParseAgeGroups(s string) ([]AgeGroup, error) {
+	var ret []AgeGroup
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		var a AgeGroup
+		switch {
+		case strings.HasSuffix(v, "+"):
+			if _, err := fmt.Sscanf(v, "%d+", &a.MinAge); err != nil {
+				return nil, fmt.Errorf("failed to parse age group %q: %v", v, err)
+			}
+		case strings.Contains(v, "-"):
+			if _, err := fmt.Sscanf(v, "%d-%d", &a.MinAge, &a.MaxAge); err != nil {
+				return nil, fmt.Errorf("failed to parse age group %q: %v", v, err)
+			}
+		default:
+			if _, err := fmt.Sscanf(v, "%d", &a.MinAge); err != nil {
+				return nil, fmt.Errorf("failed to parse age group %q: %v", v, err)
+			}
+			a.MaxAge = a.MinAge
+		}
+		ret = append(ret, a)
+	}
+	return ret, nil
+}
+
+func (e *Event) AgeGroup() AgeGroup {
+	return AgeGroup{MinAge: e.MinAge, MaxAge: e.MaxAge}
+}
+
+// CombineEvents returns an event that seeds the entries of the given age
+// group events together. The age group events are kept in Combined so the
+// results can still be placed separately. The events must be distinct and
+// of the same gender, stroke and distance.
+func CombineEvents(events ...*Event) (*Event, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events to combine")
+	}
+	first := events[0]
+	ret := &Event{
+		Classification: first.Classification,
+		Gender:         first.Gender,
+		Type:           first.Type,
+		MinAge:         first.MinAge,
+		MaxAge:         first.MaxAge,
+		Distance:       first.Distance,
+		Stroke:         first.Stroke,
+		EventFee:       first.EventFee,
+	}
+	var numbers []string
+	seen := make(map[string]bool)
+	for _, e := range events {
+		number := strings.TrimSpace(e.Number)
+		if seen[number] {
+			return nil, fmt.Errorf("event %v is combined more than once", e.Number)
+		}
+		seen[number] = true
+		if e.Distance != first.Distance || e.Stroke != first.Stroke || e.Type != first.Type || e.Gender != first.Gender {
+			return nil, fmt.Errorf("can't combine event %v with event %v", e.Number, first.Number)
+		}
+		if e.MinAge < ret.MinAge {
+			ret.MinAge = e.MinAge
+		}
+		switch {
+		case ret.AgeGroup().Open():
+		case e.AgeGroup().Open() || e.MaxAge > ret.MaxAge:
+			ret.MaxAge = e.MaxAge
+		}
+		numbers = append(numbers, e.Number)
+		ret.Entries = append(ret.Entries, e.Entries...)
+		ret.Combined = append(ret.Combined, e)
+	}
+	ret.Number = strings.Join(numbers, "/")
+	sort.Sort(ret.Entries)
+	return ret, nil
+}
+
+// ResultGroups returns the events results are placed in. This is the age
+// group events of a combined event, a copy of the event for each of its
+// AgeGroups or the event itself.
+func (e *Event) ResultGroups() []*Event {
+	if len(e.Combined) > 0 {
+		return e.Combined
+	}
+	if len(e.AgeGroups) == 0 {
+		return []*Event{e}
+	}
+	var ret []*Event
+	for _, group := range e.AgeGroups {
+		g := *e
+		g.MinAge = group.MinAge
+		g.MaxAge = group.MaxAge
+		g.AgeGroups = nil
+		g.Entries = nil
+		for _, entry := range e.Entries {
+			if group.Contains(entry.Swimmer.Age) {
+				g.Entries = append(g.Entries, entry)
+			}
+		}
+		ret = append(ret, &g)
+	}
+	return ret
+}
+
+// CombineEvents replaces the events with the given numbers by a single
+// combined event, see CombineEvents.
+func (m *Meet) CombineEvents(numbers ...string) (*Event, error) {
+	var events []*Event
+	for _, number := range numbers {
+		e := m.lookupEvent(number)
+		if e == nil {
+			return nil, fmt.Errorf("unknown event number %q", number)
+		}
+		events = append(events, e)
+	}
+	combined, err := CombineEvents(events...)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Event
+	for _, e := range m.Events {
+		switch {
+		case e == events[0]:
+			ret = append(ret, combined)
+		case e.containedIn(events):
+		default:
+			ret = append(ret, e)
+		}
+	}
+	m.Events = ret
+	return combined, nil
+}
+
+// SplitEvent places the results of a wide age event separately for each of
+// the age groups.
+func (m *Meet) SplitEvent(number string, groups ...AgeGroup) error {
+	e := m.lookupEvent(number)
+	if e == nil {
+		return fmt.Errorf("unknown event number %q", number)
+	}
+	e.AgeGroups = groups
+	return nil
+}
+
+func (m *Meet) lookupEvent(number string) *Event {
+	for _, e := range m.Events {
+		if e.Number == number {
+			return e
+		}
+	}
+	return nil
+}
+
+func (e *Event) containedIn(events []*Event) bool {
+	for _, v := range events {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package hytek
+
+import (
+	"testing"
+)
+
+func TestCombineEvents(t *testing.T) {
+	event := func(number string, gender Gender, minAge, maxAge int) *Event {
+		return &Event{Number: number, Gender: gender, Stroke: Freestyle, Distance: 50, MinAge: minAge, MaxAge: maxAge}
+	}
+	tests := []struct {
+		name    string
+		events  []*Event
+		want    AgeGroup
+		wantErr bool
+	}{
+		{
+			name:   "age groups",
+			events: []*Event{event("1A", Female, 11, 12), event("1B", Female, 9, 10)},
+			want:   AgeGroup{MinAge: 9, MaxAge: 12},
+		},
+		{
+			name:   "open age group last",
+			events: []*Event{event("1A", Female, 11, 12), event("1B", Female, 13, 0)},
+			want:   AgeGroup{MinAge: 11},
+		},
+		{
+			name:   "open age group first",
+			events: []*Event{event("1A", Female, 13, 0), event("1B", Female, 11, 12)},
+			want:   AgeGroup{MinAge: 11},
+		},
+		{
+			name:   "open age group from HYV",
+			events: []*Event{event("1A", Female, 13, 99), event("1B", Female, 11, 12)},
+			want:   AgeGroup{MinAge: 11, MaxAge: 99},
+		},
+		{
+			name:    "same event twice",
+			events:  []*Event{event("1A", Female, 9, 10), event("1A", Female, 9, 10)},
+			wantErr: true,
+		},
+		{
+			name:    "different genders",
+			events:  []*Event{event("1A", Female, 9, 10), event("2A", Male, 9, 10)},
+			wantErr: true,
+		},
+		{
+			name:    "different strokes",
+			events:  []*Event{event("1A", Female, 9, 10), {Number: "2A", Gender: Female, Stroke: Backstroke, Distance: 50, MinAge: 11, MaxAge: 12}},
+			wantErr: true,
+		},
+		{
+			name:    "different distances",
+			events:  []*Event{event("1A", Female, 9, 10), {Number: "2A", Gender: Female, Stroke: Freestyle, Distance: 100, MinAge: 11, MaxAge: 12}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := CombineEvents(test.events...)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("combined as %v, want error", got.Number)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.AgeGroup() != test.want {
+				t.Errorf("got age group %+v, want %+v", got.AgeGroup(), test.want)
+			}
+			if got.Gender != Female {
+				t.Errorf("got gender %v, want %v", got.Gender, Female)
+			}
+		})
+	}
+}
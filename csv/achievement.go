@@ -0,0 +1,68 @@
+package csv
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/standards"
+	"github.com/jszwec/csvutil"
+)
+
+type Achievement struct {
+	Standard  string           `csv:"Standard"`
+	Team      string           `csv:"Team"`
+	ID        string           `csv:"ID"`
+	LastName  string           `csv:"LastName"`
+	FirstName string           `csv:"FirstName"`
+	Gender    hytek.Gender     `csv:"Gender"`
+	Age       int              `csv:"Age"`
+	Event     string           `csv:"Event"`
+	Stroke    hytek.StrokeCode `csv:"Stroke"`
+	Distance  int              `csv:"Distance"`
+	Time      hytek.HY3Time    `csv:"Time"`
+	Previous  hytek.HY3Time    `csv:"Previous"`
+}
+
+// ToAchievements lists the standards achieved, one row per standard, for
+// qualification lists.
+func ToAchievements(achievements []*standards.Achievement) Achievements {
+	var ret Achievements
+	for _, a := range achievements {
+		v := &Achievement{
+			Standard:  a.Standard.Name,
+			ID:        a.Entry.Swimmer.ID,
+			LastName:  a.Entry.Swimmer.LastName,
+			FirstName: a.Entry.Swimmer.FirstName,
+			Gender:    a.Entry.Swimmer.Gender,
+			Age:       a.Entry.Swimmer.Age,
+			Event:     a.Event.Number,
+			Stroke:    a.Event.Stroke,
+			Distance:  a.Event.Distance,
+			Time:      a.Time,
+			Previous:  a.Previous,
+		}
+		if a.Entry.Team != nil && a.Entry.Team.Name != nil {
+			v.Team = a.Entry.Team.Name.Abbr
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+type Achievements []*Achievement
+
+func (a Achievements) Write(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
+	if err := e.EncodeHeader(&Achievement{}); err != nil {
+		return err
+	}
+	for _, v := range a {
+		if err := e.Encode(v); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,70 @@
+package csv
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+	"github.com/jszwec/csvutil"
+)
+
+type Ranking struct {
+	Course    string           `csv:"Course"`
+	Gender    hytek.Gender     `csv:"Gender"`
+	AgeGroup  string           `csv:"AgeGroup"`
+	Stroke    hytek.StrokeCode `csv:"Stroke"`
+	Distance  int              `csv:"Distance"`
+	Rank      int              `csv:"Rank"`
+	ID        string           `csv:"ID"`
+	LastName  string           `csv:"LastName"`
+	FirstName string           `csv:"FirstName"`
+	Age       int              `csv:"Age"`
+	Team      string           `csv:"Team"`
+	Time      hytek.HY3Time    `csv:"Time"`
+	Meet      string           `csv:"Meet"`
+	Date      string           `csv:"Date"`
+}
+
+// ToRankings flattens the ranking lists to one row per ranked swim.
+func ToRankings(rankings []*history.Ranking) Rankings {
+	var ret Rankings
+	for _, r := range rankings {
+		for _, v := range r.Swims {
+			ret = append(ret, &Ranking{
+				Course:    r.Course,
+				Gender:    r.Gender,
+				AgeGroup:  r.AgeGroup.String(),
+				Stroke:    r.Stroke,
+				Distance:  r.Distance,
+				Rank:      v.Rank,
+				ID:        v.Swim.ID,
+				LastName:  v.Swim.LastName,
+				FirstName: v.Swim.FirstName,
+				Age:       v.Swim.Age,
+				Team:      v.Swim.Team,
+				Time:      v.Swim.Time,
+				Meet:      v.Swim.Meet,
+				Date:      v.Swim.Date,
+			})
+		}
+	}
+	return ret
+}
+
+type Rankings []*Ranking
+
+func (r Rankings) Write(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
+	if err := e.EncodeHeader(&Ranking{}); err != nil {
+		return err
+	}
+	for _, v := range r {
+		if err := e.Encode(v); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/points"
 	"github.com/jszwec/csvutil"
 )
 
@@ -28,9 +29,20 @@ type Result struct {
 	Split8        hytek.HY3Time     `csv:"Split8"`
 	DQDescription string            `csv:"DQ description"`
 	DQCode        string            `csv:"DQ code"`
+	Points        int               `csv:"Points,omitempty"`
 }
 
 func MeetToResults(m *hytek.Meet) Results {
+	return meetToResults(m, nil)
+}
+
+// MeetToResultsWithPoints is MeetToResults with the World Aquatics points of
+// each result.
+func MeetToResultsWithPoints(m *hytek.Meet, b *points.BaseTimes) Results {
+	return meetToResults(m, b)
+}
+
+func meetToResults(m *hytek.Meet, b *points.BaseTimes) Results {
 	var ret Results
 	for _, event := range m.Events {
 		if len(event.Entries) == 0 {
@@ -47,6 +59,9 @@ func MeetToResults(m *hytek.Meet) Results {
 				Time:      entry.Entry.Result.Time,
 				TimeCode:  entry.Entry.Result.TimeCode,
 			}
+			if b != nil {
+				r.Points = b.EntryPoints(m.CourseCode, event, entry)
+			}
 			if entry.Entry.Result.DQDescription != nil {
 				r.DQDescription = entry.Entry.Result.DQDescription.Description
 				r.DQCode = entry.Entry.Result.DQDescription.Code
@@ -124,7 +139,8 @@ func (r *Result) Splits() (res []*hytek.HY3Splits) {
 type Results []*Result
 
 func (r Results) Write(w io.Writer) error {
-	e := csvutil.NewEncoder(csv.NewWriter(w))
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
 	if err := e.EncodeHeader(&Result{}); err != nil {
 		return err
 	}
@@ -133,7 +149,8 @@ func (r Results) Write(w io.Writer) error {
 			return err
 		}
 	}
-	return nil
+	cw.Flush()
+	return cw.Error()
 }
 
 func (r Results) Parse(rr io.Reader) error {
@@ -0,0 +1,65 @@
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/scoring"
+	"github.com/jszwec/csvutil"
+)
+
+type Standing struct {
+	Category string  `csv:"Category"`
+	Place    int     `csv:"Place"`
+	Team     string  `csv:"Team"`
+	TeamName string  `csv:"TeamName"`
+	Points   float64 `csv:"Points"`
+}
+
+// ScoresToStandings lists the team standings overall, by gender and by age
+// group.
+func ScoresToStandings(scores scoring.Scores) Standings {
+	ret := standings("Overall", scores.Overall())
+	byGender := scores.ByGender()
+	for _, g := range []hytek.Gender{hytek.Female, hytek.Male, hytek.Mixed} {
+		ret = append(ret, standings(g.Display(), byGender[g])...)
+	}
+	byAge := scores.ByAgeGroup()
+	for _, a := range scores.AgeGroups() {
+		ret = append(ret, standings(fmt.Sprintf("Age %v", a), byAge[a])...)
+	}
+	return ret
+}
+
+func standings(category string, s scoring.Standings) Standings {
+	var ret Standings
+	for _, v := range s {
+		ret = append(ret, &Standing{
+			Category: category,
+			Place:    v.Place,
+			Team:     v.Team.Name.Abbr,
+			TeamName: v.Team.Name.Name,
+			Points:   v.Points,
+		})
+	}
+	return ret
+}
+
+type Standings []*Standing
+
+func (s Standings) Write(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
+	if err := e.EncodeHeader(&Standing{}); err != nil {
+		return err
+	}
+	for _, v := range s {
+		if err := e.Encode(v); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,139 @@
+package hytek
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"sort"
+)
+
+// TieBreak compares two entries with the same seed time. It returns a
+// negative number when a should be seeded ahead of b, a positive number when
+// b should be seeded ahead of a and zero when it cannot separate them.
+type TieBreak func(a, b *Entry) int
+
+// TieBreakAge seeds the older swimmer first.
+func TieBreakAge(a, b *Entry) int {
+	return b.Swimmer.Age - a.Swimmer.Age
+}
+
+// TieBreakSeedTime2 seeds the swimmer with the faster alternate seed time
+// first. Swimmers without an alternate seed time go last.
+func TieBreakSeedTime2(a, b *Entry) int {
+	return compareTimes(a.Entry.SeedTime2, b.Entry.SeedTime2)
+}
+
+// TieBreakSubmission seeds the entry submitted first ahead.
+func TieBreakSubmission(a, b *Entry) int {
+	return a.Submission - b.Submission
+}
+
+func compareTimes(a, b HY3Time) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 1
+	case b == 0:
+		return -1
+	case a < b:
+		return -1
+	}
+	return 1
+}
+
+func seedLess(a, b *Entry, tieBreaks []TieBreak) bool {
+	if c := compareTimes(a.Entry.SeedTime1, b.Entry.SeedTime1); c != 0 {
+		return c < 0
+	}
+	for _, t := range tieBreaks {
+		if c := t(a, b); c != 0 {
+			return c < 0
+		}
+	}
+	if a.Submission != b.Submission {
+		return a.Submission < b.Submission
+	}
+	return a.Swimmer.ID < b.Swimmer.ID
+}
+
+type seedOrder struct {
+	Entries
+	tieBreaks []TieBreak
+}
+
+func (s seedOrder) Less(i, j int) bool {
+	return seedLess(s.Entries[i], s.Entries[j], s.tieBreaks)
+}
+
+// SortEntries orders the entries by seed time, applying the tie breaks in
+// turn to entries with equal seed times. Entries that are still tied are
+// left in submission order.
+func (e *Event) SortEntries(tieBreaks ...TieBreak) {
+	sort.Sort(seedOrder{Entries: e.Entries, tieBreaks: tieBreaks})
+}
+
+// Draw is a random draw used to break ties in seeding. The draw for each
+// event is recorded so that it can be saved and reused when the heats are
+// seeded again.
+type Draw struct {
+	Seed int64
+	// Events holds the swimmer IDs for each event number in draw order.
+	Events map[string][]string
+}
+
+func NewDraw(seed int64) *Draw {
+	return &Draw{
+		Seed:   seed,
+		Events: make(map[string][]string),
+	}
+}
+
+func ReadDraw(r io.Reader) (*Draw, error) {
+	d := NewDraw(0)
+	if err := json.NewDecoder(r).Decode(d); err != nil {
+		return nil, err
+	}
+	if d.Events == nil {
+		d.Events = make(map[string][]string)
+	}
+	return d, nil
+}
+
+func (d *Draw) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// TieBreak draws the swimmers of the event and returns a tie break that
+// seeds them in draw order. Swimmers already drawn for the event keep their
+// position; any new entries are drawn after them.
+func (d *Draw) TieBreak(e *Event) TieBreak {
+	position := make(map[string]int)
+	for i, id := range d.Events[e.Number] {
+		position[id] = i
+	}
+	var undrawn []string
+	for _, entry := range e.Entries {
+		if _, ok := position[entry.Swimmer.ID]; !ok {
+			position[entry.Swimmer.ID] = -1
+			undrawn = append(undrawn, entry.Swimmer.ID)
+		}
+	}
+	sort.Strings(undrawn)
+	h := fnv.New64a()
+	h.Write([]byte(e.Number))
+	r := rand.New(rand.NewSource(d.Seed ^ int64(h.Sum64())))
+	r.Shuffle(len(undrawn), func(i, j int) {
+		undrawn[i], undrawn[j] = undrawn[j], undrawn[i]
+	})
+	for _, id := range undrawn {
+		position[id] = len(d.Events[e.Number])
+		d.Events[e.Number] = append(d.Events[e.Number], id)
+	}
+	return TieBreak(func(a, b *Entry) int {
+		return position[a.Swimmer.ID] - position[b.Swimmer.ID]
+	})
+}
@@ -0,0 +1,51 @@
+package hytek
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func testDrawEvent() *Event {
+	e := &Event{Number: "1A"}
+	for i := 0; i < 8; i++ {
+		e.Entries = append(e.Entries, &Entry{
+			Swimmer: &HY3SwimmerInfo1{ID: fmt.Sprint(i)},
+			Entry:   &HY3IndividualEventEntryInfo{SeedTime1: 30},
+		})
+	}
+	return e
+}
+
+func drawOrder(d *Draw) []string {
+	e := testDrawEvent()
+	e.SortEntries(d.TieBreak(e))
+	var ret []string
+	for _, entry := range e.Entries {
+		ret = append(ret, entry.Swimmer.ID)
+	}
+	return ret
+}
+
+func TestDraw(t *testing.T) {
+	want := drawOrder(NewDraw(42))
+	if got := drawOrder(NewDraw(42)); !reflect.DeepEqual(got, want) {
+		t.Errorf("same seed drew %v, want %v", got, want)
+	}
+	d := NewDraw(42)
+	drawOrder(d)
+	var buf bytes.Buffer
+	if err := d.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := ReadDraw(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Changing the seed shows the saved draw is used rather than drawn again.
+	saved.Seed = 7
+	if got := drawOrder(saved); !reflect.DeepEqual(got, want) {
+		t.Errorf("saved draw read back drew %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,295 @@
+package history
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/jszwec/csvutil"
+)
+
+const dateFormat = "2006-01-02"
+
+// Swim is one result of a swimmer, as kept in the history.
+type Swim struct {
+	ID        string           `csv:"ID"`
+	LastName  string           `csv:"LastName"`
+	FirstName string           `csv:"FirstName"`
+	Gender    hytek.Gender     `csv:"Gender"`
+	Age       int              `csv:"Age"`
+	Team      string           `csv:"Team"`
+	Meet      string           `csv:"Meet"`
+	Date      string           `csv:"Date"`
+	Course    string           `csv:"Course"`
+	Stroke    hytek.StrokeCode `csv:"Stroke"`
+	Distance  int              `csv:"Distance"`
+	Time      hytek.HY3Time    `csv:"Time"`
+	// when is the parsed Date, set by Add.
+	when time.Time
+}
+
+// When returns the date of the swim.
+func (s *Swim) When() (time.Time, error) {
+	t, err := time.Parse(dateFormat, s.Date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q of swim by %v: %v", s.Date, s.ID, err)
+	}
+	return t, nil
+}
+
+func (s *Swim) sameEvent(o *Swim) bool {
+	return s.Course == o.Course && s.Stroke == o.Stroke && s.Distance == o.Distance
+}
+
+// History holds every swim of each swimmer, keyed on the swimmer's ID.
+type History struct {
+	swims map[string][]*Swim
+	meets map[string]bool
+}
+
+func New() *History {
+	return &History{
+		swims: make(map[string][]*Swim),
+		meets: make(map[string]bool),
+	}
+}
+
+// Read loads a history written by Write.
+func Read(r io.Reader) (*History, error) {
+	d, err := csvutil.NewDecoder(csv.NewReader(r))
+	if err != nil {
+		if err == io.EOF {
+			return New(), nil
+		}
+		return nil, err
+	}
+	var swims []*Swim
+	if err := d.Decode(&swims); err != nil {
+		return nil, err
+	}
+	h := New()
+	for _, s := range swims {
+		if err := h.Add(s); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+func (h *History) Write(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
+	if err := e.EncodeHeader(&Swim{}); err != nil {
+		return err
+	}
+	for _, id := range h.Swimmers() {
+		for _, s := range h.swims[id] {
+			if err := e.Encode(s); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func meetKey(meet, date string) string {
+	return meet + "|" + date
+}
+
+// Add records a swim, keeping each swimmer's swims in date order. It fails
+// if the date of the swim is invalid.
+func (h *History) Add(s *Swim) error {
+	when, err := s.When()
+	if err != nil {
+		return err
+	}
+	s.when = when
+	swims := append(h.swims[s.ID], s)
+	sort.SliceStable(swims, func(i, j int) bool { return swims[i].Date < swims[j].Date })
+	h.swims[s.ID] = swims
+	h.meets[meetKey(s.Meet, s.Date)] = true
+	return nil
+}
+
+// removeMeet drops the swims of a meet.
+func (h *History) removeMeet(meet, date string) {
+	for id, swims := range h.swims {
+		var kept []*Swim
+		for _, s := range swims {
+			if s.Meet != meet || s.Date != date {
+				kept = append(kept, s)
+			}
+		}
+		if len(kept) == 0 {
+			delete(h.swims, id)
+		} else {
+			h.swims[id] = kept
+		}
+	}
+	delete(h.meets, meetKey(meet, date))
+}
+
+// Swimmers returns the IDs of the swimmers in the history, sorted.
+func (h *History) Swimmers() []string {
+	var ret []string
+	for id := range h.swims {
+		ret = append(ret, id)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Swims returns the swims of a swimmer in date order.
+func (h *History) Swims(id string) []*Swim {
+	return h.swims[id]
+}
+
+// Best returns the swimmer's fastest swim of the same course, stroke and
+// distance as s from before the date, or nil if there is none.
+func (h *History) Best(s *Swim, before time.Time) *Swim {
+	var ret *Swim
+	for _, v := range h.swims[s.ID] {
+		if !v.sameEvent(s) || !v.when.Before(before) {
+			continue
+		}
+		if ret == nil || v.Time < ret.Time {
+			ret = v
+		}
+	}
+	return ret
+}
+
+// Improvement compares a swim with the swimmer's previous best.
+type Improvement struct {
+	Swim *Swim
+	// Event is the event of the swim as the results file records it.
+	Event *hytek.Event
+	// Previous is the previous best time, from the history or the seed time
+	// of the entry when the history has no earlier swim. It is zero for a
+	// first swim of the event.
+	Previous hytek.HY3Time
+	// PB is set when the swim is faster than the previous best, or is the
+	// first swim of the event.
+	PB bool
+}
+
+func (i *Improvement) First() bool {
+	return i.Previous == 0
+}
+
+// Delta returns how much faster the swim was than the previous best.
+func (i *Improvement) Delta() hytek.HY3Time {
+	if i.First() {
+		return 0
+	}
+	return i.Previous - i.Swim.Time
+}
+
+// Percent returns the improvement as a percentage of the previous best.
+func (i *Improvement) Percent() float64 {
+	if i.First() {
+		return 0
+	}
+	return float64(i.Delta()) / float64(i.Previous) * 100
+}
+
+// AddMeet adds the results of a HY3 file to the history and compares each
+// with the swimmer's previous best. Results of a meet already in the
+// history replace it, so corrected results can be added again.
+func (h *History) AddMeet(f *hytek.HY3) ([]*Improvement, error) {
+	if f.MeetInfo == nil {
+		return nil, fmt.Errorf("no meet information in results")
+	}
+	start, err := f.MeetInfo.StartDate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid meet start date %q: %v", f.MeetInfo.Start, err)
+	}
+	meet := strings.TrimSpace(f.MeetInfo.Name)
+	date := start.Format(dateFormat)
+	if h.meets[meetKey(meet, date)] {
+		h.removeMeet(meet, date)
+	}
+	var ret []*Improvement
+	var swims []*Swim
+	for _, team := range f.Teams {
+		abbr := ""
+		if team.Name != nil {
+			abbr = team.Name.Abbr
+		}
+		for _, swimmer := range team.Swimmers {
+			if swimmer.Info1 == nil || swimmer.Info1.ID == "" {
+				continue
+			}
+			for _, entry := range swimmer.IndividualEntries {
+				r := entry.Result
+				if !r.Placeable() {
+					continue
+				}
+				s := &Swim{
+					ID:        strings.TrimSpace(swimmer.Info1.ID),
+					LastName:  swimmer.Info1.LastName,
+					FirstName: swimmer.Info1.FirstName,
+					Gender:    swimmer.Info1.Gender,
+					Age:       swimmer.Info1.Age,
+					Team:      abbr,
+					Meet:      meet,
+					Date:      date,
+					Course:    r.LengthUnit,
+					Stroke:    entry.Stroke,
+					Distance:  entry.Distance,
+					Time:      r.Time,
+				}
+				i := &Improvement{Swim: s, Event: entryEvent(entry)}
+				if best := h.Best(s, start); best != nil {
+					i.Previous = best.Time
+				} else if entry.SeedCourse1 == r.LengthUnit {
+					i.Previous = entry.SeedTime1
+				}
+				i.PB = i.First() || s.Time < i.Previous
+				ret = append(ret, i)
+				swims = append(swims, s)
+			}
+		}
+	}
+	for _, s := range swims {
+		if err := h.Add(s); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// entryEvent is the event of the entry as the results file records it.
+// Missing or unreadable ages leave the event open.
+func entryEvent(e *hytek.HY3IndividualEventEntryInfo) *hytek.Event {
+	ret := &hytek.Event{
+		Number:   strings.TrimSpace(e.EventNumber),
+		Gender:   e.Gender1,
+		Type:     hytek.Individual,
+		Distance: e.Distance,
+		Stroke:   e.Stroke,
+	}
+	if ret.Gender == "" {
+		ret.Gender = e.Gender
+	}
+	ret.MinAge, _ = strconv.Atoi(strings.TrimSpace(e.AgeLower))
+	ret.MaxAge, _ = strconv.Atoi(strings.TrimSpace(e.AgeUpper))
+	return ret
+}
+
+// PBs returns the improvements that are personal bests.
+func PBs(improvements []*Improvement) []*Improvement {
+	var ret []*Improvement
+	for _, i := range improvements {
+		if i.PB {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
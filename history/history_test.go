@@ -0,0 +1,52 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func testMeet(name, start string, times ...hytek.HY3Time) *hytek.HY3 {
+	swimmer := &hytek.HY3Swimmer{Info1: &hytek.HY3SwimmerInfo1{ID: "S1", LastName: "Swimmer"}}
+	for _, t := range times {
+		swimmer.IndividualEntries = append(swimmer.IndividualEntries, &hytek.HY3IndividualEventEntryInfo{
+			Stroke:   hytek.Freestyle,
+			Distance: 50,
+			Result:   &hytek.HY3IndividualEventResults{Time: t, LengthUnit: "S"},
+		})
+	}
+	return &hytek.HY3{
+		MeetInfo: &hytek.HY3MeetInfo{Name: name, Start: start},
+		Teams:    []*hytek.HY3SwimTeam{{Swimmers: []*hytek.HY3Swimmer{swimmer}}},
+	}
+}
+
+func TestAddMeetReplacesMeet(t *testing.T) {
+	h := New()
+	if _, err := h.AddMeet(testMeet("Autumn", "09012026", 32)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.AddMeet(testMeet("Winter", "12012026", 31)); err != nil {
+		t.Fatal(err)
+	}
+	// The corrected results of the second meet replace the first ones.
+	improvements, err := h.AddMeet(testMeet("Winter", "12012026", 30.5))
+	if err != nil {
+		t.Fatalf("AddMeet of a meet already in the history: %v", err)
+	}
+	if got := len(h.Swims("S1")); got != 2 {
+		t.Errorf("got %v swims, want 2", got)
+	}
+	if len(improvements) != 1 {
+		t.Fatalf("got %v improvements, want 1", len(improvements))
+	}
+	if i := improvements[0]; i.Previous != 32 || !i.PB {
+		t.Errorf("Previous = %v, PB = %v; want 32, true", i.Previous, i.PB)
+	}
+}
+
+func TestAddInvalidDate(t *testing.T) {
+	if err := New().Add(&Swim{ID: "S1", Date: "01/12/2026"}); err == nil {
+		t.Error("Add of a swim with an invalid date didn't fail")
+	}
+}
@@ -0,0 +1,123 @@
+package history
+
+import (
+	"sort"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+// RankingFilter limits the swims that are ranked. Zero fields don't filter.
+type RankingFilter struct {
+	// Team is the abbreviation of the club the swims were for.
+	Team string
+	// From and To are the first and last dates of swims to rank.
+	From time.Time
+	To   time.Time
+}
+
+func (f RankingFilter) match(s *Swim) bool {
+	if f.Team != "" && s.Team != f.Team {
+		return false
+	}
+	if !f.From.IsZero() && s.when.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && s.when.After(f.To) {
+		return false
+	}
+	return true
+}
+
+// Ranked is a swimmer's best swim in a ranking list.
+type Ranked struct {
+	Rank int
+	Swim *Swim
+}
+
+// Ranking is the best time of each swimmer for one course, gender, age
+// group, stroke and distance, fastest first.
+type Ranking struct {
+	Course   string
+	Gender   hytek.Gender
+	AgeGroup hytek.AgeGroup
+	Stroke   hytek.StrokeCode
+	Distance int
+	Swims    []*Ranked
+}
+
+type rankingKey struct {
+	course   string
+	gender   hytek.Gender
+	group    hytek.AgeGroup
+	stroke   hytek.StrokeCode
+	distance int
+}
+
+// Rankings ranks the best swim of each swimmer by course, gender, age
+// group, stroke and distance. A swim counts in the age group of the
+// swimmer's age when it was swum, or in a single open ranking if there are
+// no age groups. Swimmers on the same time share a rank.
+func (h *History) Rankings(groups []hytek.AgeGroup, f RankingFilter) []*Ranking {
+	if len(groups) == 0 {
+		groups = []hytek.AgeGroup{{}}
+	}
+	lists := make(map[rankingKey]*Ranking)
+	best := make(map[rankingKey]map[string]*Swim)
+	var ret []*Ranking
+	for _, id := range h.Swimmers() {
+		for _, s := range h.swims[id] {
+			if !f.match(s) {
+				continue
+			}
+			for _, g := range groups {
+				if !g.Contains(s.Age) {
+					continue
+				}
+				k := rankingKey{course: s.Course, gender: s.Gender, group: g, stroke: s.Stroke, distance: s.Distance}
+				if _, ok := lists[k]; !ok {
+					lists[k] = &Ranking{Course: s.Course, Gender: s.Gender, AgeGroup: g, Stroke: s.Stroke, Distance: s.Distance}
+					best[k] = make(map[string]*Swim)
+					ret = append(ret, lists[k])
+				}
+				if b, ok := best[k][id]; !ok || s.Time < b.Time {
+					best[k][id] = s
+				}
+				break
+			}
+		}
+	}
+	for k, r := range lists {
+		for _, s := range best[k] {
+			r.Swims = append(r.Swims, &Ranked{Swim: s})
+		}
+		sort.Slice(r.Swims, func(i, j int) bool {
+			a, b := r.Swims[i].Swim, r.Swims[j].Swim
+			if a.Time != b.Time {
+				return a.Time < b.Time
+			}
+			return a.ID < b.ID
+		})
+		for i, v := range r.Swims {
+			v.Rank = i + 1
+			if i > 0 && v.Swim.Time == r.Swims[i-1].Swim.Time {
+				v.Rank = r.Swims[i-1].Rank
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		switch {
+		case a.Course != b.Course:
+			return a.Course < b.Course
+		case a.Gender != b.Gender:
+			return a.Gender < b.Gender
+		case a.AgeGroup != b.AgeGroup:
+			return a.AgeGroup.MinAge < b.AgeGroup.MinAge
+		case a.Stroke != b.Stroke:
+			return a.Stroke < b.Stroke
+		}
+		return a.Distance < b.Distance
+	})
+	return ret
+}
@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"strings"
+	"time"
 
 	fixedwidth "github.com/countcraicula/go-fixedwidth"
 )
@@ -29,9 +31,15 @@ type HY3 struct {
 
 func PopulateMeetEntries(m *Meet, h *HY3) error {
 	events := make(map[string]*Event)
+	combined := make(map[*Event]*Event)
 	for _, event := range m.Events {
 		events[event.Number] = event
+		for _, c := range event.Combined {
+			events[c.Number] = c
+			combined[c] = event
+		}
 	}
+	submission := 0
 	for _, team := range h.Teams {
 		for _, swimmer := range team.Swimmers {
 			for _, entry := range swimmer.IndividualEntries {
@@ -39,12 +47,28 @@ func PopulateMeetEntries(m *Meet, h *HY3) error {
 				if !ok {
 					return fmt.Errorf("unknown event number %q", entry.EventNumber)
 				}
-				e.Entries = append(e.Entries, &Entry{Swimmer: swimmer.Info1, Entry: entry})
+				submission++
+				ee := &Entry{Team: team, Swimmer: swimmer.Info1, Entry: entry, Submission: submission}
+				e.Entries = append(e.Entries, ee)
+				if c, ok := combined[e]; ok {
+					c.Entries = append(c.Entries, ee)
+				}
+			}
+		}
+		for _, relay := range team.Relays {
+			e, ok := events[strings.Trim(relay.EventNumber, " ")]
+			if !ok {
+				return fmt.Errorf("unknown relay event number %q", relay.EventNumber)
 			}
+			submission++
+			e.Relays = append(e.Relays, &Entry{Team: team, RelayEntry: relay, Submission: submission})
 		}
 	}
 	for _, e := range m.Events {
 		sort.Sort(e.Entries)
+		for _, c := range e.Combined {
+			sort.Sort(c.Entries)
+		}
 	}
 	return nil
 }
@@ -70,6 +94,12 @@ type HY3MeetInfo struct {
 	Elevation string `fixed:"117,121,right"`
 }
 
+// StartDate parses the first day of the meet, which HY3 files record as
+// MMDDYYYY.
+func (m *HY3MeetInfo) StartDate() (time.Time, error) {
+	return time.Parse("01022006", strings.TrimSpace(m.Start))
+}
+
 type HY3MeetAddress struct {
 	HY3Line  `fixed:"1,2"`
 	Unknown1 string     `fixed:"3,94"`
@@ -91,6 +121,7 @@ type HY3SwimTeam struct {
 	Address  *HY3SwimTeamAddressInfo
 	Contact  *HY3SwimTeamContactInfo
 	Swimmers []*HY3Swimmer
+	Relays   []*HY3RelayEventEntryInfo
 }
 
 type HY3SwimTeamNameInfo struct {
@@ -226,29 +257,33 @@ type HY3IndividualEventResults struct {
 type HY3TimeCode string
 
 const (
-	TimeCodeNormal     HY3TimeCode = " "
-	TimeCodeScratch    HY3TimeCode = "S"
-	TimeCodeNoShow     HY3TimeCode = "R"
-	TimeCodeFalseStart HY3TimeCode = "F"
+	TimeCodeNormal       HY3TimeCode = " "
+	TimeCodeScratch      HY3TimeCode = "S"
+	TimeCodeNoShow       HY3TimeCode = "R"
+	TimeCodeFalseStart   HY3TimeCode = "F"
+	TimeCodeDisqualified HY3TimeCode = "Q"
 )
 
 type HY3RelayEventEntryInfo struct {
-	HY3Line     `fixed:"1,2"`
-	TeamAbbr    string  `fixed:"3,7"`
-	RelayTeam   string  `fixed:"8,8"`
-	Gender      Gender  `fixed:"13,13"`
-	Gender1     Gender  `fixed:"14,14"`
-	Gender2     Gender  `fixed:"15,15"`
-	Distance    int     `fixed:"18,21"`
-	Stroke      string  `fixed:"22,22"`
-	AgeLower    string  `fixed:"23,25"`
-	AgeUpper    string  `fixed:"26,28"`
-	EventFee    HY3Time `fixed:"33,38"`
-	EventNumber string  `fixed:"39,41"`
-	SeedTime1   string  `fixed:"44,44"`
-	SeedCourse1 string  `fixed:"51,51"`
-	SeedTime2   string  `fixed:"53,59"`
-	SeedCourse2 string  `fixed:"60,60"`
+	HY3Line             `fixed:"1,2"`
+	TeamAbbr            string         `fixed:"3,7"`
+	RelayTeam           string         `fixed:"8,8"`
+	Gender              Gender         `fixed:"13,13"`
+	Gender1             Gender         `fixed:"14,14"`
+	Gender2             Gender         `fixed:"15,15"`
+	Distance            int            `fixed:"18,21,right"`
+	Stroke              StrokeCode     `fixed:"22,22"`
+	AgeLower            string         `fixed:"23,25,right"`
+	AgeUpper            string         `fixed:"26,28,right"`
+	EventFee            float32        `fixed:"33,38,right"`
+	EventNumber         string         `fixed:"39,42,right"`
+	ConversionSeedTime1 HY3DefaultTime `fixed:"43,50,right"`
+	ConversionCourse1   string         `fixed:"51,51"`
+	SeedTime1           HY3Time        `fixed:"53,59,right"`
+	SeedCourse1         string         `fixed:"60,60"`
+	// Result is read from the F2 record, which has the layout of E2.
+	Result *HY3IndividualEventResults
+	LineUp *HY3RelayEventLineUp
 }
 
 type HY3RelayEventResults struct {
@@ -304,7 +339,8 @@ func (t *HY3Time) UnmarshalTextFixedWidth(b []byte) error {
 }
 
 func (t HY3Time) MarshalCSV() ([]byte, error) {
-	return []byte(fmt.Sprintf("%v:%v.%v", int(t)/60, int(t)%60, t-HY3Time(int(t)))), nil
+	h := int(math.Round(float64(t) * 100))
+	return []byte(fmt.Sprintf("%d:%02d.%02d", h/6000, h/100%60, h%100)), nil
 }
 
 func (t *HY3Time) UnmarshalCSV(b []byte) error {
@@ -428,6 +464,15 @@ type HY3SplitTime struct {
 	Time   HY3Time `fixed:"4,11,right"`
 }
 
+// Distance returns the distance swum at the split, which is recorded in
+// lengths of the pool.
+func (h *HY3SplitTime) Distance(course CourseCode) int {
+	if course == LongMeters {
+		return h.Length * 50
+	}
+	return h.Length * 25
+}
+
 type HY3DQDescription struct {
 	HY3Line     `fixed:"1,2"`
 	Code        string `fixed:"3,4"`
@@ -488,6 +533,7 @@ func ParseHY3File(r io.Reader) (*HY3, error) {
 	var currTeam *HY3SwimTeam
 	var currSwimmer *HY3Swimmer
 	var currIndividualEntry *HY3IndividualEventEntryInfo
+	var currRelayEntry *HY3RelayEventEntryInfo
 	var currResult *HY3IndividualEventResults
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -570,6 +616,33 @@ func ParseHY3File(r io.Reader) (*HY3, error) {
 				return nil, err
 			}
 			currIndividualEntry.Result = currResult
+		case "F1":
+			if currTeam == nil {
+				return nil, fmt.Errorf("F1 before Team info")
+			}
+			currRelayEntry = &HY3RelayEventEntryInfo{}
+			if err := fixedwidth.Unmarshal([]byte(line), currRelayEntry); err != nil {
+				return nil, err
+			}
+			currTeam.Relays = append(currTeam.Relays, currRelayEntry)
+			currResult = nil
+		case "F2":
+			if currRelayEntry == nil {
+				return nil, fmt.Errorf("F2 before F1")
+			}
+			currResult = &HY3IndividualEventResults{}
+			if err := fixedwidth.Unmarshal([]byte(line), currResult); err != nil {
+				return nil, err
+			}
+			currRelayEntry.Result = currResult
+		case "F3":
+			if currRelayEntry == nil {
+				return nil, fmt.Errorf("F3 before F1")
+			}
+			currRelayEntry.LineUp = &HY3RelayEventLineUp{}
+			if err := fixedwidth.Unmarshal([]byte(line), currRelayEntry.LineUp); err != nil {
+				return nil, err
+			}
 		case "G1":
 			if currResult == nil {
 				return nil, fmt.Errorf("G1 before Result info")
@@ -600,6 +673,23 @@ func GenerateHY3File(m *HY3, w io.Writer) error {
 		v.setType(t)
 		return enc.Encode(v)
 	}
+	encodeResult := func(result *HY3IndividualEventResults, t string) error {
+		if result == nil {
+			return nil
+		}
+		if err := encode(result, t); err != nil {
+			return err
+		}
+		for _, v := range result.Splits {
+			if err := encode(v, "G1"); err != nil {
+				return err
+			}
+		}
+		if result.DQDescription != nil {
+			return encode(result.DQDescription, "H1")
+		}
+		return nil
+	}
 	if m.FileDescriptor != nil {
 		if err := encode(m.FileDescriptor, "A1"); err != nil {
 			return err
@@ -645,22 +735,21 @@ func GenerateHY3File(m *HY3, w io.Writer) error {
 				if err := encode(entry, "E1"); err != nil {
 					return err
 				}
-				result := entry.Result
-				if result == nil {
-					continue
-				}
-				if err := encode(result, "E2"); err != nil {
+				if err := encodeResult(entry.Result, "E2"); err != nil {
 					return err
 				}
-				for _, v := range result.Splits {
-					if err := encode(v, "G1"); err != nil {
-						return err
-					}
-				}
-				if result.DQDescription != nil {
-					if err := encode(result.DQDescription, "H1"); err != nil {
-						return err
-					}
+			}
+		}
+		for _, relay := range team.Relays {
+			if err := encode(relay, "F1"); err != nil {
+				return err
+			}
+			if err := encodeResult(relay.Result, "F2"); err != nil {
+				return err
+			}
+			if relay.LineUp != nil {
+				if err := encode(relay.LineUp, "F3"); err != nil {
+					return err
 				}
 			}
 		}
@@ -0,0 +1,82 @@
+package hytek
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHY3TimeCSV(t *testing.T) {
+	tests := []struct {
+		time HY3Time
+		want string
+	}{
+		{29.12, "0:29.12"},
+		{65.5, "1:05.50"},
+		{59.999, "1:00.00"},
+		{0, "0:00.00"},
+	}
+	for _, test := range tests {
+		b, err := test.time.MarshalCSV()
+		if err != nil {
+			t.Fatalf("MarshalCSV(%v): %v", test.time, err)
+		}
+		if string(b) != test.want {
+			t.Errorf("MarshalCSV(%v) = %q, want %q", test.time, b, test.want)
+		}
+		var got HY3Time
+		if err := got.UnmarshalCSV(b); err != nil {
+			t.Fatalf("UnmarshalCSV(%q): %v", b, err)
+		}
+		if b, _ := got.MarshalCSV(); string(b) != test.want {
+			t.Errorf("UnmarshalCSV(%q) read back as %q", test.want, b)
+		}
+	}
+}
+
+func TestHY3Relays(t *testing.T) {
+	team := &HY3SwimTeam{
+		Name: &HY3SwimTeamNameInfo{Abbr: "ABC", Name: "A Swim Club"},
+		Relays: []*HY3RelayEventEntryInfo{{
+			TeamAbbr:    "ABC",
+			RelayTeam:   "A",
+			Gender:      Mixed,
+			Distance:    100,
+			Stroke:      Medley,
+			EventNumber: "10",
+			SeedTime1:   75.5,
+			Result:      &HY3IndividualEventResults{Time: 74.21, Heat: 1, Lane: 4, PlaceOverall: 2},
+			LineUp:      &HY3RelayEventLineUp{ID1: 1, Leg1: 1, ID2: 2, Leg2: 2},
+		}},
+	}
+	var buf bytes.Buffer
+	if err := GenerateHY3File(&HY3{Teams: []*HY3SwimTeam{team}}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ParseHY3File(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Teams) != 1 || len(h.Teams[0].Relays) != 1 {
+		t.Fatalf("read back %v teams, want 1 with 1 relay", len(h.Teams))
+	}
+	got := h.Teams[0].Relays[0]
+	if got.RelayTeam != "A" || got.Distance != 100 || got.Stroke != Medley || got.SeedTime1 != 75.5 {
+		t.Errorf("read back relay %+v", got)
+	}
+	if got.Result == nil || got.Result.Time != 74.21 || got.Result.Lane != 4 || got.Result.PlaceOverall != 2 {
+		t.Errorf("read back result %+v", got.Result)
+	}
+	if got.LineUp == nil || got.LineUp.ID2 != 2 || got.LineUp.Leg2 != 2 {
+		t.Errorf("read back line up %+v", got.LineUp)
+	}
+	event := &Event{Number: "10", Type: Relay}
+	if err := PopulateMeetEntries(&Meet{Events: []*Event{event}}, h); err != nil {
+		t.Fatal(err)
+	}
+	if len(event.Relays) != 1 || event.Relays[0].RelayEntry != got || event.Relays[0].Team != h.Teams[0] {
+		t.Errorf("event relays = %v, want the relay of the team", event.Relays)
+	}
+	if len(event.Entries) != 0 {
+		t.Errorf("relay added to the individual entries")
+	}
+}
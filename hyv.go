@@ -96,24 +96,33 @@ func (s *StrokeCode) UnmarshalTextFixedWidth(b []byte) error {
 }
 
 type Entry struct {
+	Team       *HY3SwimTeam
 	Swimmer    *HY3SwimmerInfo1
 	Entry      *HY3IndividualEventEntryInfo
 	RelayEntry *HY3RelayEventEntryInfo
+	// Submission is the position of the entry in the entry file.
+	Submission int
+	// Exhibition swims are timed but not placed.
+	Exhibition bool
+}
+
+// Result is the result of the individual or relay swim, nil when there is
+// none.
+func (e *Entry) Result() *HY3IndividualEventResults {
+	switch {
+	case e.Entry != nil:
+		return e.Entry.Result
+	case e.RelayEntry != nil:
+		return e.RelayEntry.Result
+	}
+	return nil
 }
 
 type Entries []*Entry
 
+// Less orders entries by seed time, breaking ties with TieBreakAge.
 func (e Entries) Less(i, j int) bool {
-	if e[i].Entry.SeedTime1 == 0 && e[j].Entry.SeedTime1 != 0 {
-		return false
-	}
-	if e[j].Entry.SeedTime1 == 0 && e[i].Entry.SeedTime1 != 0 {
-		return true
-	}
-	if e[i].Entry.SeedTime1 == e[j].Entry.SeedTime1 {
-		return e[i].Swimmer.Age > e[j].Swimmer.Age
-	}
-	return e[i].Entry.SeedTime1 < e[j].Entry.SeedTime1
+	return seedLess(e[i], e[j], []TieBreak{TieBreakAge})
 }
 func (e Entries) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
 func (e Entries) Len() int      { return len(e) }
@@ -138,6 +147,12 @@ type Event struct {
 	Unknown6       string
 	Unknown7       string
 	Entries        Entries
+	// Relays are the relay team entries of a relay event.
+	Relays Entries
+	// Combined holds the age group events seeded together as this event.
+	Combined []*Event
+	// AgeGroups splits the results of a wide age event by age group.
+	AgeGroups []AgeGroup
 }
 
 func sortByLaneOrder(h []*Entry) {
@@ -200,6 +215,59 @@ func (e *Event) AssignHeats(numLanes int) {
 
 }
 
+// MoveToLaterHeat swaps the entry with the swimmer of the next heat seeded
+// closest to it, the nearest lane first on a tie, so both heats stay
+// seeded. Swimmers seeded more than maxGap apart, and entries for which
+// fixed returns true, are not swapped. It returns false if there is no one
+// to swap with.
+func (e *Event) MoveToLaterHeat(entry *Entry, maxGap HY3Time, fixed func(*Entry) bool) bool {
+	r := entry.Entry.Result
+	if r == nil {
+		return false
+	}
+	seed := entry.Entry.SeedTime1
+	var swap *Entry
+	for _, v := range e.Entries {
+		vr := v.Entry.Result
+		if vr == nil || vr.Heat != r.Heat+1 || (fixed != nil && fixed(v)) {
+			continue
+		}
+		gap := seedGap(v.Entry.SeedTime1, seed)
+		if gap > maxGap {
+			continue
+		}
+		if swap == nil {
+			swap = v
+			continue
+		}
+		best := seedGap(swap.Entry.SeedTime1, seed)
+		if gap < best || (gap == best && laneDistance(vr.Lane, r.Lane) < laneDistance(swap.Entry.Result.Lane, r.Lane)) {
+			swap = v
+		}
+	}
+	if swap == nil {
+		return false
+	}
+	sr := swap.Entry.Result
+	r.Heat, sr.Heat = sr.Heat, r.Heat
+	r.Lane, sr.Lane = sr.Lane, r.Lane
+	return true
+}
+
+func seedGap(a, b HY3Time) HY3Time {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func laneDistance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
 func (e *Event) String() string {
 	return fmt.Sprintf("%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v;%v",
 		e.Number,
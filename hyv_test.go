@@ -0,0 +1,79 @@
+package hytek
+
+import (
+	"testing"
+)
+
+type testSeed struct {
+	heat, lane int
+	seed       HY3Time
+}
+
+func TestMoveToLaterHeat(t *testing.T) {
+	tests := []struct {
+		name  string
+		seeds []testSeed
+		fixed int
+		// want is the entry swapped with the first, -1 for none.
+		want int
+	}{
+		{
+			name:  "closest seed rather than nearest lane",
+			seeds: []testSeed{{1, 3, 40}, {2, 3, 35}, {2, 1, 39.5}},
+			fixed: -1,
+			want:  2,
+		},
+		{
+			name:  "nearest lane on a tie",
+			seeds: []testSeed{{1, 3, 40}, {2, 1, 39}, {2, 4, 41}},
+			fixed: -1,
+			want:  2,
+		},
+		{
+			name:  "no one seeded close enough",
+			seeds: []testSeed{{1, 3, 40}, {2, 3, 37.5}, {2, 4, 43}},
+			fixed: -1,
+			want:  -1,
+		},
+		{
+			name:  "fixed entries stay",
+			seeds: []testSeed{{1, 3, 40}, {2, 3, 40}, {2, 4, 41.5}},
+			fixed: 1,
+			want:  2,
+		},
+		{
+			name:  "no later heat",
+			seeds: []testSeed{{2, 3, 40}, {1, 3, 40}},
+			fixed: -1,
+			want:  -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &Event{}
+			for _, s := range test.seeds {
+				e.Entries = append(e.Entries, &Entry{Entry: &HY3IndividualEventEntryInfo{
+					SeedTime1: s.seed,
+					Result:    &HY3IndividualEventResults{Heat: s.heat, Lane: s.lane},
+				}})
+			}
+			fixed := func(v *Entry) bool { return test.fixed >= 0 && v == e.Entries[test.fixed] }
+			if got := e.MoveToLaterHeat(e.Entries[0], 2, fixed); got != (test.want >= 0) {
+				t.Fatalf("MoveToLaterHeat = %v, want %v", got, test.want >= 0)
+			}
+			for i, s := range test.seeds {
+				want := s
+				switch {
+				case test.want < 0:
+				case i == 0:
+					want = test.seeds[test.want]
+				case i == test.want:
+					want = test.seeds[0]
+				}
+				if r := e.Entries[i].Entry.Result; r.Heat != want.heat || r.Lane != want.lane {
+					t.Errorf("entry %v in heat %v lane %v, want heat %v lane %v", i, r.Heat, r.Lane, want.heat, want.lane)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package hytek
+
+import (
+	"sort"
+)
+
+// Disqualified reports whether the swim was disqualified.
+func (r *HY3IndividualEventResults) Disqualified() bool {
+	return r != nil && (r.TimeCode == TimeCodeDisqualified || r.TimeCode == TimeCodeFalseStart || r.DQDescription != nil)
+}
+
+// Placeable reports whether the swim has a time that counts for a place. A
+// scratch code on a swim with a time is ignored, as results without a finals
+// swim are recorded that way.
+func (r *HY3IndividualEventResults) Placeable() bool {
+	return r != nil && r.Time > 0 && r.TimeCode != TimeCodeNoShow && !r.Disqualified()
+}
+
+// Place sets PlaceInHeat and PlaceOverall on the results of the event and
+// its relays. Swimmers with the same time share a place and the next place
+// is skipped. Disqualified swims, no shows and exhibition swims are not
+// placed. The overall places of combined and split events are set
+// separately for each age group, and swims outside every age group of a
+// split event are left unplaced. Relays are placed in the event they were
+// entered in.
+func (e *Event) Place() {
+	for _, entry := range e.Entries {
+		if r := entry.Result(); r != nil {
+			r.PlaceInHeat, r.PlaceOverall = 0, 0
+		}
+	}
+	placeHeats(e.Entries)
+	for _, g := range e.ResultGroups() {
+		placeEntries(g.Entries, func(r *HY3IndividualEventResults, place int) {
+			r.PlaceOverall = place
+		})
+	}
+	for _, r := range e.RelayEvents() {
+		placeHeats(r.Relays)
+		placeEntries(r.Relays, func(r *HY3IndividualEventResults, place int) {
+			r.PlaceOverall = place
+		})
+	}
+}
+
+// RelayEvents are the events holding the relays of the event, the age
+// group events of a combined event.
+func (e *Event) RelayEvents() []*Event {
+	return append([]*Event{e}, e.Combined...)
+}
+
+func placeHeats(entries []*Entry) {
+	heats := make(map[int][]*Entry)
+	for _, entry := range entries {
+		if r := entry.Result(); r != nil {
+			heats[r.Heat] = append(heats[r.Heat], entry)
+		}
+	}
+	for _, entries := range heats {
+		placeEntries(entries, func(r *HY3IndividualEventResults, place int) {
+			r.PlaceInHeat = place
+		})
+	}
+}
+
+// MarkExhibition flags the entries of the meet the function picks as
+// exhibition swims, so they are not placed or scored. HY3 files don't say
+// which swims are exhibition, so they have to be marked before placing.
+func (m *Meet) MarkExhibition(exhibition func(*Entry) bool) {
+	for _, e := range m.Events {
+		entries := append(Entries(nil), e.Entries...)
+		for _, r := range e.RelayEvents() {
+			entries = append(entries, r.Relays...)
+		}
+		for _, entry := range entries {
+			if exhibition(entry) {
+				entry.Exhibition = true
+			}
+		}
+	}
+}
+
+func placeEntries(entries []*Entry, set func(*HY3IndividualEventResults, int)) {
+	var placed []*Entry
+	for _, entry := range entries {
+		r := entry.Result()
+		if r == nil {
+			continue
+		}
+		set(r, 0)
+		if entry.Exhibition || !r.Placeable() {
+			continue
+		}
+		placed = append(placed, entry)
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		return placed[i].Result().Time < placed[j].Result().Time
+	})
+	place := 0
+	for i, entry := range placed {
+		if i == 0 || entry.Result().Time != placed[i-1].Result().Time {
+			place = i + 1
+		}
+		set(entry.Result(), place)
+	}
+}
+
+// SortByPlace orders the entries by overall place. Unplaced swims follow:
+// exhibition swims by time, then disqualifications, then no shows.
+func SortByPlace(entries []*Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		ri, rj := resultRank(entries[i]), resultRank(entries[j])
+		if ri != rj {
+			return ri < rj
+		}
+		a, b := entries[i].Entry.Result, entries[j].Entry.Result
+		if ri == 0 && a.PlaceOverall != b.PlaceOverall {
+			return a.PlaceOverall < b.PlaceOverall
+		}
+		return a.Time < b.Time
+	})
+}
+
+func resultRank(e *Entry) int {
+	r := e.Entry.Result
+	switch {
+	case r == nil:
+		return 4
+	case r.PlaceOverall > 0:
+		return 0
+	case r.Placeable():
+		return 1
+	case r.Disqualified():
+		return 2
+	}
+	return 3
+}
@@ -0,0 +1,158 @@
+package hytek
+
+import (
+	"testing"
+)
+
+type testSwim struct {
+	time       HY3Time
+	code       HY3TimeCode
+	dq         bool
+	exhibition bool
+}
+
+func testEntries(swims []testSwim) []*Entry {
+	var ret []*Entry
+	for _, s := range swims {
+		r := &HY3IndividualEventResults{Time: s.time, TimeCode: s.code, PlaceOverall: 99}
+		if s.dq {
+			r.DQDescription = &HY3DQDescription{}
+		}
+		ret = append(ret, &Entry{Entry: &HY3IndividualEventEntryInfo{Result: r}, Exhibition: s.exhibition})
+	}
+	return ret
+}
+
+func TestPlaceEntries(t *testing.T) {
+	tests := []struct {
+		name  string
+		swims []testSwim
+		want  []int
+	}{
+		{
+			name:  "by time",
+			swims: []testSwim{{time: 31.2}, {time: 29.8}, {time: 30.5}},
+			want:  []int{3, 1, 2},
+		},
+		{
+			name:  "ties share a place and skip the next",
+			swims: []testSwim{{time: 30}, {time: 29}, {time: 30}, {time: 31}},
+			want:  []int{2, 1, 2, 4},
+		},
+		{
+			name: "disqualifications are not placed",
+			swims: []testSwim{
+				{time: 28, code: TimeCodeDisqualified},
+				{time: 29, code: TimeCodeFalseStart},
+				{time: 29.5, dq: true},
+				{time: 30},
+			},
+			want: []int{0, 0, 0, 1},
+		},
+		{
+			name: "no shows and scratches without a time are not placed",
+			swims: []testSwim{
+				{time: 0, code: TimeCodeNoShow},
+				{time: 25, code: TimeCodeNoShow},
+				{time: 0, code: TimeCodeScratch},
+				{time: 30},
+			},
+			want: []int{0, 0, 0, 1},
+		},
+		{
+			name:  "scratches with a time are placed",
+			swims: []testSwim{{time: 30}, {time: 29, code: TimeCodeScratch}},
+			want:  []int{2, 1},
+		},
+		{
+			name: "exhibition swims are not placed",
+			swims: []testSwim{
+				{time: 28, exhibition: true},
+				{time: 30},
+				{time: 30, exhibition: true},
+				{time: 31},
+			},
+			want: []int{0, 1, 0, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries := testEntries(test.swims)
+			placeEntries(entries, func(r *HY3IndividualEventResults, place int) {
+				r.PlaceOverall = place
+			})
+			for i, want := range test.want {
+				if got := entries[i].Entry.Result.PlaceOverall; got != want {
+					t.Errorf("swim %v: place = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkExhibition(t *testing.T) {
+	a := &Entry{Entry: &HY3IndividualEventEntryInfo{Result: &HY3IndividualEventResults{Time: 30}}, Submission: 1}
+	b := &Entry{Entry: &HY3IndividualEventEntryInfo{Result: &HY3IndividualEventResults{Time: 29}}, Submission: 2}
+	m := &Meet{Events: []*Event{{Entries: Entries{a, b}}}}
+	m.MarkExhibition(func(e *Entry) bool { return e.Submission == 2 })
+	m.Events[0].Place()
+	if a.Exhibition || !b.Exhibition {
+		t.Fatalf("Exhibition = %v, %v, want false, true", a.Exhibition, b.Exhibition)
+	}
+	if a.Entry.Result.PlaceOverall != 1 || b.Entry.Result.PlaceOverall != 0 {
+		t.Errorf("places = %v, %v, want 1, 0", a.Entry.Result.PlaceOverall, b.Entry.Result.PlaceOverall)
+	}
+}
+
+func TestPlaceRelays(t *testing.T) {
+	relay := func(heat int, time HY3Time) *Entry {
+		return &Entry{RelayEntry: &HY3RelayEventEntryInfo{Result: &HY3IndividualEventResults{Heat: heat, Time: time}}}
+	}
+	a, b, c := relay(1, 70), relay(1, 68), relay(2, 69)
+	e := &Event{Type: Relay, Relays: Entries{a, b, c}}
+	e.Place()
+	for i, want := range []struct{ heat, overall int }{{2, 3}, {1, 1}, {1, 2}} {
+		r := e.Relays[i].Result()
+		if r.PlaceInHeat != want.heat || r.PlaceOverall != want.overall {
+			t.Errorf("relay %v placed %v in heat and %v overall, want %v and %v", i, r.PlaceInHeat, r.PlaceOverall, want.heat, want.overall)
+		}
+	}
+}
+
+func TestPlaceCombinedAndSplit(t *testing.T) {
+	swim := func(age, heat int, time HY3Time) *Entry {
+		return &Entry{
+			Swimmer: &HY3SwimmerInfo1{Age: age},
+			Entry:   &HY3IndividualEventEntryInfo{Result: &HY3IndividualEventResults{Heat: heat, Time: time, PlaceInHeat: 99, PlaceOverall: 99}},
+		}
+	}
+	t.Run("combined", func(t *testing.T) {
+		a1, a2, b1 := swim(10, 1, 31), swim(10, 1, 33), swim(12, 1, 32)
+		a := &Event{Number: "1A", MinAge: 9, MaxAge: 10, Entries: Entries{a1, a2}}
+		b := &Event{Number: "1B", MinAge: 11, MaxAge: 12, Entries: Entries{b1}}
+		e, err := CombineEvents(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.Place()
+		// Heat places count across the age groups swum together, overall
+		// places within each.
+		for i, want := range []struct{ heat, overall int }{{1, 1}, {3, 2}, {2, 1}} {
+			r := []*Entry{a1, a2, b1}[i].Entry.Result
+			if r.PlaceInHeat != want.heat || r.PlaceOverall != want.overall {
+				t.Errorf("swim %v placed %v in heat and %v overall, want %v and %v", i, r.PlaceInHeat, r.PlaceOverall, want.heat, want.overall)
+			}
+		}
+	})
+	t.Run("split", func(t *testing.T) {
+		in, out := swim(10, 1, 31), swim(14, 1, 30)
+		e := &Event{Entries: Entries{in, out}, AgeGroups: []AgeGroup{{MinAge: 9, MaxAge: 10}}}
+		e.Place()
+		if r := in.Entry.Result; r.PlaceOverall != 1 {
+			t.Errorf("swim in the age group placed %v, want 1", r.PlaceOverall)
+		}
+		if r := out.Entry.Result; r.PlaceOverall != 0 {
+			t.Errorf("swim outside every age group placed %v, want 0", r.PlaceOverall)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package points
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+
+	"github.com/countcraicula/hytek"
+	"github.com/jszwec/csvutil"
+)
+
+// BaseTime is the time worth 1000 World Aquatics points.
+type BaseTime struct {
+	Course   hytek.CourseCode `csv:"Course"`
+	Gender   hytek.Gender     `csv:"Gender"`
+	Stroke   hytek.StrokeCode `csv:"Stroke"`
+	Distance int              `csv:"Distance"`
+	Time     hytek.HY3Time    `csv:"Time"`
+}
+
+type key struct {
+	course   hytek.CourseCode
+	gender   hytek.Gender
+	stroke   hytek.StrokeCode
+	distance int
+}
+
+// BaseTimes is a table of base times, published yearly by World Aquatics.
+type BaseTimes struct {
+	times map[key]hytek.HY3Time
+}
+
+func NewBaseTimes(times ...*BaseTime) *BaseTimes {
+	b := &BaseTimes{times: make(map[key]hytek.HY3Time)}
+	for _, t := range times {
+		b.times[key{course: t.Course, gender: t.Gender, stroke: t.Stroke, distance: t.Distance}] = t.Time
+	}
+	return b
+}
+
+// ParseBaseTimes reads base times from a CSV file with the columns Course,
+// Gender, Stroke, Distance and Time.
+func ParseBaseTimes(r io.Reader) (*BaseTimes, error) {
+	d, err := csvutil.NewDecoder(csv.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	var times []*BaseTime
+	if err := d.Decode(&times); err != nil {
+		return nil, err
+	}
+	return NewBaseTimes(times...), nil
+}
+
+// BaseTime returns the base time of the event, or zero if there is none.
+func (b *BaseTimes) BaseTime(course hytek.CourseCode, gender hytek.Gender, stroke hytek.StrokeCode, distance int) hytek.HY3Time {
+	if b == nil {
+		return 0
+	}
+	return b.times[key{course: course, gender: gender, stroke: stroke, distance: distance}]
+}
+
+// Points returns the World Aquatics points of a time, 1000*(B/T)^3
+// truncated to a whole number. It returns zero if there is no time or no
+// base time for the event.
+func (b *BaseTimes) Points(course hytek.CourseCode, gender hytek.Gender, stroke hytek.StrokeCode, distance int, t hytek.HY3Time) int {
+	base := b.BaseTime(course, gender, stroke, distance)
+	if base == 0 || t == 0 {
+		return 0
+	}
+	return int(1000 * math.Pow(float64(base)/float64(t), 3))
+}
+
+// EntryPoints returns the points of the entry's result in the event.
+func (b *BaseTimes) EntryPoints(course hytek.CourseCode, e *hytek.Event, entry *hytek.Entry) int {
+	if entry.Entry == nil || !entry.Entry.Result.Placeable() {
+		return 0
+	}
+	gender := e.Gender
+	if entry.Swimmer != nil {
+		gender = entry.Swimmer.Gender
+	}
+	return b.Points(course, gender, e.Stroke, e.Distance, entry.Entry.Result.Time)
+}
@@ -0,0 +1,204 @@
+package records
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/countcraicula/hytek"
+	"github.com/jszwec/csvutil"
+)
+
+type Record struct {
+	Course   hytek.CourseCode `csv:"Course"`
+	Gender   hytek.Gender     `csv:"Gender"`
+	MinAge   int              `csv:"MinAge"`
+	MaxAge   int              `csv:"MaxAge"`
+	Stroke   hytek.StrokeCode `csv:"Stroke"`
+	Distance int              `csv:"Distance"`
+	Time     hytek.HY3Time    `csv:"Time"`
+	Holder   string           `csv:"Holder"`
+	Team     string           `csv:"Team"`
+	Date     string           `csv:"Date"`
+	Meet     string           `csv:"Meet"`
+}
+
+func (r *Record) AgeGroup() hytek.AgeGroup {
+	return hytek.AgeGroup{MinAge: r.MinAge, MaxAge: r.MaxAge}
+}
+
+// Table is a set of records such as meet, club or national records.
+type Table struct {
+	Name string
+	// Code marks results that break a record of the table, e.g. "M" for
+	// meet records.
+	Code    string
+	Records []*Record
+}
+
+// ParseTable reads a record table from a CSV file with the columns of
+// Record.
+func ParseTable(name, code string, r io.Reader) (*Table, error) {
+	d, err := csvutil.NewDecoder(csv.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	t := &Table{Name: name, Code: code}
+	if err := d.Decode(&t.Records); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Table) Write(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	e := csvutil.NewEncoder(cw)
+	if err := e.EncodeHeader(&Record{}); err != nil {
+		return err
+	}
+	for _, r := range t.Records {
+		if err := e.Encode(r); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Lookup returns the records a swimmer of the gender and age can break.
+func (t *Table) Lookup(course hytek.CourseCode, gender hytek.Gender, age int, stroke hytek.StrokeCode, distance int) []*Record {
+	var ret []*Record
+	for _, r := range t.Records {
+		if r.Course == course && r.Gender == gender && r.Stroke == stroke && r.Distance == distance && r.AgeGroup().Contains(age) {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// EventRecords returns the records for the event's gender, age group,
+// stroke and distance.
+func (t *Table) EventRecords(course hytek.CourseCode, e *hytek.Event) []*Record {
+	var ret []*Record
+	for _, r := range t.Records {
+		if r.Course == course && (e.Gender == hytek.Mixed || r.Gender == e.Gender) && r.Stroke == e.Stroke && r.Distance == e.Distance &&
+			r.MinAge <= e.MaxAge && (r.MaxAge == 0 || r.MaxAge >= e.MinAge) {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// Break is a swim that broke or equalled a record.
+type Break struct {
+	Table  *Table
+	Record *Record
+	Event  *hytek.Event
+	Entry  *hytek.Entry
+	Time   hytek.HY3Time
+	// Split is set when the record was broken by a split and not the
+	// final time of the swim.
+	Split    bool
+	Equalled bool
+}
+
+// Mark returns the code that marks the break on a result, e.g. "M" or "=M"
+// for an equalled record.
+func (b *Break) Mark() string {
+	if b.Equalled {
+		return "=" + b.Table.Code
+	}
+	return b.Table.Code
+}
+
+func (b *Break) String() string {
+	s := "broke"
+	if b.Equalled {
+		s = "equalled"
+	}
+	if b.Split {
+		s += " (split)"
+	}
+	return fmt.Sprintf("%v %v %v record %v", b.Entry.Swimmer.FirstName, b.Entry.Swimmer.LastName, s, b.Table.Name)
+}
+
+// Check compares each result of the events, and each split of the same
+// stroke, with the records of the table. Exhibition swims can't set
+// records.
+func (t *Table) Check(course hytek.CourseCode, events []*hytek.Event) []*Break {
+	var ret []*Break
+	for _, event := range events {
+		for _, entry := range event.Entries {
+			if entry.Swimmer == nil || entry.Entry == nil || entry.Exhibition || !entry.Entry.Result.Placeable() {
+				continue
+			}
+			r := entry.Entry.Result
+			ret = append(ret, t.check(course, event, entry, event.Distance, r.Time, false)...)
+			if event.Stroke == hytek.Medley {
+				continue
+			}
+			for _, splits := range r.Splits {
+				for _, split := range splits.Times {
+					d := split.Distance(course)
+					if d >= event.Distance || split.Time == 0 {
+						continue
+					}
+					ret = append(ret, t.check(course, event, entry, d, split.Time, true)...)
+				}
+			}
+		}
+	}
+	return ret
+}
+
+func (t *Table) check(course hytek.CourseCode, event *hytek.Event, entry *hytek.Entry, distance int, time hytek.HY3Time, split bool) []*Break {
+	var ret []*Break
+	for _, record := range t.Lookup(course, entry.Swimmer.Gender, entry.Swimmer.Age, event.Stroke, distance) {
+		if record.Time != 0 && time > record.Time {
+			continue
+		}
+		ret = append(ret, &Break{
+			Table:    t,
+			Record:   record,
+			Event:    event,
+			Entry:    entry,
+			Time:     time,
+			Split:    split,
+			Equalled: time == record.Time,
+		})
+	}
+	return ret
+}
+
+// Update sets each record to the fastest swim that broke it. A swimmer who
+// equals a record is added as a joint holder.
+func (t *Table) Update(breaks []*Break, meet string, date string) {
+	sort.SliceStable(breaks, func(i, j int) bool { return breaks[i].Time < breaks[j].Time })
+	updated := make(map[*Record]hytek.HY3Time)
+	for _, b := range breaks {
+		if b.Table != t {
+			continue
+		}
+		if best, ok := updated[b.Record]; ok && b.Time > best {
+			continue
+		}
+		r := b.Record
+		holder := fmt.Sprintf("%v %v", b.Entry.Swimmer.FirstName, b.Entry.Swimmer.LastName)
+		team := ""
+		if b.Entry.Team != nil && b.Entry.Team.Name != nil {
+			team = b.Entry.Team.Name.Abbr
+		}
+		if b.Equalled || updated[r] == b.Time {
+			r.Holder = fmt.Sprintf("%v / %v", r.Holder, holder)
+			r.Team = fmt.Sprintf("%v / %v", r.Team, team)
+		} else {
+			r.Holder = holder
+			r.Team = team
+		}
+		r.Time = b.Time
+		r.Date = date
+		r.Meet = meet
+		updated[r] = b.Time
+	}
+}
@@ -0,0 +1,135 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/standards"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// swimmerAchievements are the standards one swimmer achieved, one line per
+// event.
+type swimmerAchievements struct {
+	swimmer *hytek.HY3SwimmerInfo1
+	events  []*hytek.Event
+	byEvent map[*hytek.Event][]*standards.Achievement
+}
+
+type teamAchievements struct {
+	team     string
+	name     string
+	swimmers []*swimmerAchievements
+}
+
+// groupAchievements groups the achievements of the club of the options by
+// team and swimmer, sorted by team and swimmer name.
+func groupAchievements(achievements []*standards.Achievement, s *SheetOptions) []*teamAchievements {
+	teams := make(map[string]*teamAchievements)
+	swimmers := make(map[*hytek.HY3SwimmerInfo1]*swimmerAchievements)
+	var ret []*teamAchievements
+	for _, a := range achievements {
+		if !inClub(s, a.Entry) {
+			continue
+		}
+		abbr, name := "", ""
+		if a.Entry.Team != nil && a.Entry.Team.Name != nil {
+			abbr, name = a.Entry.Team.Name.Abbr, a.Entry.Team.Name.Name
+		}
+		t, ok := teams[abbr]
+		if !ok {
+			t = &teamAchievements{team: abbr, name: name}
+			teams[abbr] = t
+			ret = append(ret, t)
+		}
+		v, ok := swimmers[a.Entry.Swimmer]
+		if !ok {
+			v = &swimmerAchievements{swimmer: a.Entry.Swimmer, byEvent: make(map[*hytek.Event][]*standards.Achievement)}
+			swimmers[a.Entry.Swimmer] = v
+			t.swimmers = append(t.swimmers, v)
+		}
+		if _, ok := v.byEvent[a.Event]; !ok {
+			v.events = append(v.events, a.Event)
+		}
+		v.byEvent[a.Event] = append(v.byEvent[a.Event], a)
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].team < ret[j].team })
+	for _, t := range ret {
+		sort.SliceStable(t.swimmers, func(i, j int) bool {
+			a, b := t.swimmers[i].swimmer, t.swimmers[j].swimmer
+			if a.LastName != b.LastName {
+				return a.LastName < b.LastName
+			}
+			return a.FirstName < b.FirstName
+		})
+	}
+	return ret
+}
+
+// StandardAchievements lists, per team and swimmer, the time standards
+// achieved for the first time at the meet.
+func StandardAchievements(m *hytek.Meet, achievements []*standards.Achievement, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "Standards achieved"})
+	for _, t := range groupAchievements(achievements, s) {
+		p.Row(6, func() {})
+		p.Row(8, func() {
+			p.Col(12, func() {
+				p.Text(fmt.Sprintf("%v %v", t.team, t.name), props.Text{Style: consts.Bold})
+			})
+		})
+		p.Line(1.0)
+		p.Row(6, func() {
+			p.ColSpace(4)
+			p.Col(2, func() {
+				p.Text("Time", props.Text{Align: consts.Right, Style: consts.Bold})
+			})
+			p.Col(2, func() {
+				p.Text("Previous", props.Text{Align: consts.Right, Style: consts.Bold})
+			})
+			p.ColSpace(1)
+			p.Col(3, func() {
+				p.Text("Standards", props.Text{Style: consts.Bold})
+			})
+		})
+		for _, v := range t.swimmers {
+			p.Row(6, func() {
+				p.Col(12, func() {
+					p.Text(fmt.Sprintf("%v, %v (%v)", v.swimmer.LastName, v.swimmer.FirstName, v.swimmer.Age), props.Text{Style: consts.Bold})
+				})
+			})
+			for _, event := range v.events {
+				achievementEntry(p, s.EventTitle(m, event), v.byEvent[event])
+			}
+		}
+	}
+	return p.Output()
+}
+
+func achievementEntry(p pdf.Maroto, title string, achievements []*standards.Achievement) {
+	var names []string
+	for _, a := range achievements {
+		names = append(names, a.Standard.Name)
+	}
+	a := achievements[0]
+	p.Row(6, func() {
+		p.Col(4, func() {
+			p.Text(title, props.Text{Size: 8})
+		})
+		p.Col(2, func() {
+			p.Text(a.Time.String(), props.Text{Align: consts.Right})
+		})
+		p.Col(2, func() {
+			p.Text(a.Previous.String(), props.Text{Align: consts.Right})
+		})
+		p.ColSpace(1)
+		p.Col(3, func() {
+			p.Text(strings.Join(names, ", "))
+		})
+	})
+}
@@ -0,0 +1,165 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/records"
+)
+
+// podiumPlaces are the places announced for the podium, in the order they
+// are read out.
+var podiumPlaces = []int{3, 2, 1}
+
+// AnnouncerScript gives the announcer each event in running order with its
+// records, the lane by lane introductions of each heat and the results and
+// podium to read out, filled in once the results are in.
+func AnnouncerScript(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
+	s := applyOptions(opts)
+	var docs []*Document
+	for i, events := range sessionEvents(events, s) {
+		docs = append(docs, announcerScript(m, events, s, i+1))
+	}
+	return renderDocuments(docs, s)
+}
+
+func announcerScript(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) *Document {
+	d := &Document{
+		Header: sessionHeader(m, s, session),
+		Title:  "Announcer script",
+	}
+	events, t := runningOrder(events, s, session)
+	for _, event := range events {
+		if len(event.Entries) == 0 || !hasClubEntry(event, s) {
+			continue
+		}
+		section := &Section{
+			Title: s.EventTitle(m, event),
+			Notes: resultEventRecords(m, event, s),
+		}
+		entries := heatOrder(event.Entries)
+		heats := 0
+		if len(entries) > 0 {
+			heats = entries[len(entries)-1].Entry.Result.Heat
+		}
+		var table *Table
+		heat := 0
+		for _, entry := range entries {
+			if !inClub(s, entry) {
+				continue
+			}
+			if entry.Entry.Result.Heat != heat || table == nil {
+				heat = entry.Entry.Result.Heat
+				table = &Table{
+					Title:   fmt.Sprintf("Heat %v of %v", heat, heats),
+					Aside:   fmt.Sprintf("Starts about %v", t.HeatStart(event, heat).Format(startTimeFormat)),
+					Columns: announcerColumns,
+				}
+				section.Tables = append(section.Tables, table)
+			}
+			table.Rows = append(table.Rows, &Row{Cells: []string{
+				fmt.Sprintf("Lane %v", entry.Entry.Result.Lane),
+				fmt.Sprintf("%v %v", entry.Swimmer.FirstName, entry.Swimmer.LastName),
+				teamFullName(entry.Team),
+				entry.Entry.SeedTime1.String(),
+			}})
+		}
+		for _, group := range event.ResultGroups() {
+			section.Tables = append(section.Tables, announcerResults(m, event, group, s)...)
+		}
+		if et := t.Event(event); et != nil && et.Break != 0 {
+			section.Footer = fmt.Sprintf("%v minute break", int(et.Break.Minutes()))
+		}
+		d.Sections = append(d.Sections, section)
+	}
+	return d
+}
+
+var announcerColumns = []*Column{
+	{Width: 2},
+	{Width: 4},
+	{Width: 4},
+	{Width: 2, Align: AlignRight},
+}
+
+// announcerResults lists the results of the group with the podium to read
+// out, or leaves room to write them in if the group hasn't been swum.
+func announcerResults(m *hytek.Meet, event, group *hytek.Event, s *SheetOptions) []*Table {
+	title := "Results"
+	if group != event {
+		title = "Results - " + s.EventTitle(m, group)
+	}
+	results := &Table{
+		Title: title,
+		Columns: []*Column{
+			{Name: "Place", Width: 1, Align: AlignRight},
+			{Name: "Name", Space: 1, Width: 4},
+			{Name: "Team", Width: 4},
+			{Name: "Time", Width: 2, Align: AlignRight},
+		},
+	}
+	podium := &Table{Title: "Podium", Columns: []*Column{{Width: 12}}}
+
+	placed := make(map[int]*hytek.Entry)
+	breaks := recordBreaks(m, group, s)
+	for _, entry := range placeOrder(group.Entries) {
+		r := entry.Entry.Result
+		if r == nil || r.PlaceOverall == 0 || !inClub(s, entry) {
+			continue
+		}
+		place := r.PlaceOverall
+		final, _ := recordMarks(breaks[entry])
+		results.Rows = append(results.Rows, &Row{Cells: []string{
+			resultPlace(entry),
+			fmt.Sprintf("%v %v", entry.Swimmer.FirstName, entry.Swimmer.LastName),
+			teamFullName(entry.Team),
+			resultTime(entry) + strings.Join(final, ""),
+		}})
+		if _, ok := placed[place]; !ok {
+			placed[place] = entry
+		}
+	}
+	if len(results.Rows) == 0 {
+		// Not swum yet, leave a line for each podium place.
+		results.WriteIn = true
+		podium.WriteIn = true
+		for place := 1; place <= len(podiumPlaces); place++ {
+			results.Rows = append(results.Rows, &Row{Cells: []string{fmt.Sprintf("%v.", place)}})
+		}
+		for _, place := range podiumPlaces {
+			podium.Rows = append(podium.Rows, &Row{Cells: []string{
+				fmt.Sprintf("In %v place, ____________________ of ____________________ in ________", ordinal(place)),
+			}})
+		}
+		return []*Table{results, podium}
+	}
+	for _, place := range podiumPlaces {
+		if entry, ok := placed[place]; ok {
+			podium.Rows = append(podium.Rows, podiumRow(place, entry, breaks[entry]))
+		}
+	}
+	return []*Table{results, podium}
+}
+
+// podiumRow announces the place of the entry, with any record it broke.
+func podiumRow(place int, entry *hytek.Entry, breaks []*records.Break) *Row {
+	line := fmt.Sprintf("In %v place, %v %v of %v in %v", ordinal(place),
+		entry.Swimmer.FirstName, entry.Swimmer.LastName, teamFullName(entry.Team), entry.Entry.Result.Time)
+	var broke []string
+	for _, b := range breaks {
+		if b.Split {
+			continue
+		}
+		if b.Equalled {
+			broke = append(broke, fmt.Sprintf("equalling the %v record", b.Table.Name))
+		} else {
+			broke = append(broke, fmt.Sprintf("a new %v record", b.Table.Name))
+		}
+	}
+	if len(broke) > 0 {
+		line += ", " + strings.Join(broke, " and ")
+	}
+	return &Row{Cells: []string{line}}
+}
@@ -0,0 +1,57 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/scoring"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// HighPointAwards lists the high point winners and runners up of each age
+// group and gender.
+func HighPointAwards(m *hytek.Meet, awards []*scoring.HighPointAward, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "High point awards"})
+	for _, award := range awards {
+		p.Row(6, func() {})
+		p.Row(8, func() {
+			p.Col(12, func() {
+				p.Text(fmt.Sprintf("%v %v", award.Gender.Display(), award.AgeGroup), props.Text{Style: consts.Bold})
+			})
+		})
+		p.Line(1.0)
+		for _, v := range award.Winners() {
+			highPointEntry(p, "Winner", v)
+		}
+		for _, v := range award.RunnersUp() {
+			highPointEntry(p, "Runner up", v)
+		}
+	}
+	return p.Output()
+}
+
+func highPointEntry(p pdf.Maroto, title string, v *scoring.SwimmerPoints) {
+	p.Row(6, func() {
+		p.Col(2, func() {
+			p.Text(title)
+		})
+		p.Col(4, func() {
+			p.Text(fmt.Sprintf("%v, %v", v.Swimmer.LastName, v.Swimmer.FirstName))
+		})
+		p.Col(2, func() {
+			if v.Team != nil && v.Team.Name != nil {
+				p.Text(v.Team.Name.Abbr)
+			}
+		})
+		p.Col(2, func() {
+			p.Text(fmt.Sprintf("%v events", len(v.Scores)), props.Text{Align: consts.Right})
+		})
+		p.Col(2, func() {
+			p.Text(formatPoints(v.Points), props.Text{Align: consts.Right})
+		})
+	})
+}
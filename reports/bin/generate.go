@@ -4,7 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"sort"
+	"strings"
 	"time"
 
 	"github.com/countcraicula/hytek"
@@ -14,9 +14,24 @@ import (
 )
 
 var (
-	hy3      = flag.String("hy3", "", "")
-	hyv      = flag.String("hyv", "", "")
-	numLanes = flag.Int("num_lanes", 3, "")
+	hy3       = flag.String("hy3", "", "")
+	hyv       = flag.String("hyv", "", "")
+	numLanes  = flag.Int("num_lanes", 3, "")
+	tieBreak  = flag.String("tie_break", "age", "comma separated tie breaks for equal seed times: age, seed_time2, submission or draw")
+	drawFile  = flag.String("draw", "draw.json", "file the random draw is read from and saved to")
+	combine   = flag.String("combine", "", "semicolon separated groups of comma separated event numbers to seed together, e.g. 1A,1B;2A,2B")
+	limits    = flag.String("session_limits", "", "comma separated session lengths, e.g. 3h,2h30m; when set events are balanced across sessions")
+	fixed     = flag.String("fixed", "", "comma separated events to keep in a session when balancing, e.g. 1A=1,19=2")
+	minRest   = flag.Duration("min_rest", 5*time.Minute, "least rest a swimmer should have between swims")
+	title     = flag.String("event_title", reports.DefaultEventTitle, "template of event titles")
+	schedule  = flag.String("schedule", "", "JSON file of the sessions: dates, warm up and start times, event order and breaks")
+	resolve   = flag.Bool("resolve_conflicts", false, "move swimmers with too little rest into a later heat of their next event")
+	swapGap   = flag.Duration("max_swap_gap", 2*time.Second, "most the seed times of two swimmers may differ for them to swap heats when resolving conflicts")
+	format    = flag.String("format", "pdf", "format of the psych, heat, lane and team sheets: pdf, html or txt")
+	club      = flag.String("club", "", "abbreviation of the club to filter the sheets to, all clubs when empty")
+	teams     = flag.Bool("team_sheets", false, "write an entry confirmation and a coach sheet for each club")
+	itinerary = flag.Bool("itineraries", false, "write a PDF and iCalendar itinerary for each swimmer")
+	hyTek     = flag.Bool("hytek_text", false, "also write heat sheets in the Meet Manager text layout")
 )
 
 func main() {
@@ -48,42 +63,155 @@ func main() {
 		return
 	}
 	addMastersEvents(m, entries)
+	for _, group := range strings.Split(*combine, ";") {
+		if group == "" {
+			continue
+		}
+		if _, err := m.CombineEvents(strings.Split(group, ",")...); err != nil {
+			fmt.Println("Failed to combine events")
+			fmt.Println(err)
+			return
+		}
+	}
+	draw, err := loadDraw(*drawFile)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	events := m.Events
 	for _, event := range events {
-		sort.Sort(event.Entries)
+		tieBreaks, err := parseTieBreaks(*tieBreak, draw, event)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		event.SortEntries(tieBreaks...)
 		event.AssignHeats(*numLanes)
 	}
+	if usesDraw(*tieBreak) {
+		if err := saveDraw(*drawFile, draw); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	titleTemplate, err := reports.ParseEventTitle(*title)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	renderer, ok := reports.ParseRenderer(*format)
+	if !ok {
+		fmt.Printf("Unknown format %q\n", *format)
+		return
+	}
 	var psychOpts = []reports.SheetOption{
-		reports.SessionTimesOption([]time.Time{
-			time.Date(2022, 12, 29, 10, 30, 0, 0, time.Local),
-			time.Date(2022, 12, 30, 10, 30, 0, 0, time.Local),
-		}),
+		reports.MeetDatesOption(m),
 		reports.NumLanesOption(*numLanes),
+		reports.MinRestOption(*minRest),
+		reports.MaxSwapGapOption(*swapGap),
+		reports.EventTitleOption(titleTemplate),
+		reports.RendererOption(renderer),
+		reports.ClubOption(*club),
+	}
+	if *schedule != "" {
+		scheduleOpts, err := loadSchedule(m, *schedule)
+		if err != nil {
+			fmt.Println("Failed to load schedule")
+			fmt.Println(err)
+			return
+		}
+		psychOpts = append(psychOpts, scheduleOpts...)
+	}
+	if *limits != "" {
+		order, err := balanceSessions(events, psychOpts)
+		if err != nil {
+			fmt.Println("Failed to balance sessions")
+			fmt.Println(err)
+			return
+		}
+		psychOpts = append(psychOpts, reports.EventOrderOption(order...))
 	}
 	var opts []reports.SheetOption
 	opts = append(opts, psychOpts...)
 	opts = append(opts, reports.BySessionOption(true))
 
+	if *resolve {
+		reports.ResolveConflicts(events, opts...)
+	}
+	conflicts, err := reports.ConflictReport(m, events, opts...)
+	if err != nil {
+		fmt.Println(err)
+	}
+	os.WriteFile("conflicts.pdf", conflicts.Bytes(), 0755)
+
+	estimate, err := reports.SessionEstimate(m, events, opts...)
+	if err != nil {
+		fmt.Println(err)
+	}
+	os.WriteFile("session-estimate.pdf", estimate.Bytes(), 0755)
+
 	psychBufs, err := reports.PsychSheet(m, events, psychOpts...)
 	if err != nil {
 		fmt.Println(err)
 	}
 	for session, buf := range psychBufs {
-		os.WriteFile(fmt.Sprintf("psychsheet-%v.pdf", session+1), buf.Bytes(), 0755)
+		os.WriteFile(fmt.Sprintf("psychsheet-%v.%v", session+1, renderer.Extension()), buf.Bytes(), 0755)
 	}
 	heatBufs, err := reports.HeatSheet(m, events, opts...)
 	if err != nil {
 		fmt.Println(err)
 	}
 	for session, buf := range heatBufs {
-		os.WriteFile(fmt.Sprintf("heatsheet-%v.pdf", session+1), buf.Bytes(), 0755)
+		os.WriteFile(fmt.Sprintf("heatsheet-%v.%v", session+1, renderer.Extension()), buf.Bytes(), 0755)
+	}
+	if *hyTek {
+		textBufs, err := reports.HyTekHeatSheet(m, events, opts...)
+		if err != nil {
+			fmt.Println(err)
+		}
+		for session, buf := range textBufs {
+			os.WriteFile(fmt.Sprintf("heatsheet-hytek-%v.txt", session+1), buf.Bytes(), 0755)
+		}
 	}
 	laneBufs, err := reports.LaneSheets(m, events, opts...)
 	if err != nil {
 		fmt.Println(err)
 	}
 	for session, buf := range laneBufs {
-		os.WriteFile(fmt.Sprintf("lanesheet-%v.pdf", session+1), buf.Bytes(), 0755)
+		os.WriteFile(fmt.Sprintf("lanesheet-%v.%v", session+1, renderer.Extension()), buf.Bytes(), 0755)
+	}
+	marshalBufs, err := reports.MarshallingSheet(m, events, opts...)
+	if err != nil {
+		fmt.Println(err)
+	}
+	for session, buf := range marshalBufs {
+		os.WriteFile(fmt.Sprintf("marshalling-%v.%v", session+1, renderer.Extension()), buf.Bytes(), 0755)
+	}
+	announcerBufs, err := reports.AnnouncerScript(m, events, opts...)
+	if err != nil {
+		fmt.Println(err)
+	}
+	for session, buf := range announcerBufs {
+		os.WriteFile(fmt.Sprintf("announcer-%v.%v", session+1, renderer.Extension()), buf.Bytes(), 0755)
+	}
+	for i, team := range entries.Teams {
+		abbr := reports.TeamAbbr(team)
+		if *club != "" && !strings.EqualFold(abbr, strings.TrimSpace(*club)) {
+			continue
+		}
+		if abbr == "" {
+			abbr = fmt.Sprintf("team-%v", i+1)
+		}
+		if *itinerary {
+			if err := writeItineraries(m, team, abbr, events, opts); err != nil {
+				fmt.Println(err)
+			}
+		}
+		if *teams {
+			if err := writeTeamSheets(m, team, abbr, renderer.Extension(), events, opts); err != nil {
+				fmt.Println(err)
+			}
+		}
 	}
 	res := csv.MeetToResults(m)
 	out, err := os.Create("results.csv")
@@ -109,6 +237,140 @@ func main() {
 	}
 }
 
+// writeTeamSheets writes the entry confirmation and coach sheet of the team,
+// named by abbr with the extension of the format.
+func writeTeamSheets(m *hytek.Meet, team *hytek.HY3SwimTeam, abbr, ext string, events []*hytek.Event, opts []reports.SheetOption) error {
+	confirmation, err := reports.EntryConfirmation(m, team, opts...)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(fmt.Sprintf("entries-%v.%v", abbr, ext), confirmation.Bytes(), 0755); err != nil {
+		return err
+	}
+	coach, err := reports.CoachSheet(m, team, events, opts...)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fmt.Sprintf("coach-%v.%v", abbr, ext), coach.Bytes(), 0755)
+}
+
+// writeItineraries writes the itinerary of each swimmer of the team as PDF
+// and iCalendar files named by abbr and swimmer.
+func writeItineraries(m *hytek.Meet, team *hytek.HY3SwimTeam, abbr string, events []*hytek.Event, opts []reports.SheetOption) error {
+	for _, it := range reports.Itineraries(team, events, opts...) {
+		name := strings.Join(strings.Fields(fmt.Sprintf("itinerary %v %v %v", abbr, it.Swimmer.LastName, it.Swimmer.FirstName)), "-")
+		buf, err := reports.ItineraryPDF(m, it, opts...)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(name+".pdf", buf.Bytes(), 0755); err != nil {
+			return err
+		}
+		if buf, err = reports.ItineraryICS(m, it, opts...); err != nil {
+			return err
+		}
+		if err := os.WriteFile(name+".ics", buf.Bytes(), 0755); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadSchedule(m *hytek.Meet, path string) ([]reports.SheetOption, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s, err := reports.ReadSchedule(f)
+	if err != nil {
+		return nil, err
+	}
+	return s.Options(m)
+}
+
+func balanceSessions(events []*hytek.Event, opts []reports.SheetOption) ([]reports.OrderFunc, error) {
+	var l []time.Duration
+	for _, v := range strings.Split(*limits, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, d)
+	}
+	f := make(map[string]int)
+	for _, v := range strings.Split(*fixed, ",") {
+		if v == "" {
+			continue
+		}
+		ss := strings.SplitN(v, "=", 2)
+		if len(ss) != 2 {
+			return nil, fmt.Errorf("failed to parse fixed event %q", v)
+		}
+		var session int
+		if _, err := fmt.Sscan(ss[1], &session); err != nil {
+			return nil, fmt.Errorf("failed to parse fixed event %q: %v", v, err)
+		}
+		f[ss[0]] = session
+	}
+	return reports.BalanceSessions(events, l, f, opts...)
+}
+
+func loadDraw(name string) (*hytek.Draw, error) {
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return hytek.NewDraw(time.Now().UnixNano()), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return hytek.ReadDraw(f)
+}
+
+func saveDraw(name string, d *hytek.Draw) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := d.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// usesDraw reports whether the tie breaks include the draw, so that it is
+// only saved when it decided the order.
+func usesDraw(s string) bool {
+	for _, name := range strings.Split(s, ",") {
+		if strings.TrimSpace(name) == "draw" {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTieBreaks(s string, d *hytek.Draw, e *hytek.Event) ([]hytek.TieBreak, error) {
+	var ret []hytek.TieBreak
+	for _, name := range strings.Split(s, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "age":
+			ret = append(ret, hytek.TieBreakAge)
+		case "seed_time2":
+			ret = append(ret, hytek.TieBreakSeedTime2)
+		case "submission":
+			ret = append(ret, hytek.TieBreakSubmission)
+		case "draw":
+			ret = append(ret, d.TieBreak(e))
+		default:
+			return nil, fmt.Errorf("unknown tie break %q", name)
+		}
+	}
+	return ret, nil
+}
+
 func blankResults(e *hytek.HY3) {
 	for _, t := range e.Teams {
 		for _, s := range t.Swimmers {
@@ -165,6 +427,7 @@ func addMastersEvents(m *hytek.Meet, entries *hytek.HY3) {
 			s.IndividualEntries = append(s.IndividualEntries, entry)
 
 			e.Entries = append(e.Entries, &hytek.Entry{
+				Team:    team,
 				Swimmer: swimmer.Info1,
 				Entry:   entry,
 			})
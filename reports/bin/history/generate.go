@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+	"github.com/countcraicula/hytek/reports"
+	"github.com/golang/glog"
+)
+
+var (
+	historyFile = flag.String("history", "history.csv", "file the swim history is read from and saved to")
+	resultFiles = flag.String("results", "", "comma separated HY3 result files, oldest first, to add to the history")
+)
+
+func main() {
+	flag.Parse()
+	h, err := loadHistory()
+	if err != nil {
+		glog.Fatalf("Failed to load history: %v", err)
+	}
+	for i, v := range strings.Split(*resultFiles, ",") {
+		if v == "" {
+			continue
+		}
+		f, err := os.Open(v)
+		if err != nil {
+			glog.Fatalf("Failed to open results file: %v", err)
+		}
+		file, err := hytek.ParseHY3File(f)
+		f.Close()
+		if err != nil {
+			glog.Fatalf("Failed to parse results file %v: %v", v, err)
+		}
+		improvements, err := h.AddMeet(file)
+		if err != nil {
+			glog.Fatalf("Failed to add results file %v: %v", v, err)
+		}
+		buf, err := reports.PersonalBests(strings.TrimSpace(file.MeetInfo.Name), improvements)
+		if err != nil {
+			glog.Fatalf("Failed to generate personal bests: %v", err)
+		}
+		if err := os.WriteFile(fmt.Sprintf("pbs-%d.pdf", i+1), buf.Bytes(), 0644); err != nil {
+			glog.Fatalf("Failed to write personal bests file: %v", err)
+		}
+	}
+	out, err := os.Create(*historyFile)
+	if err != nil {
+		glog.Fatalf("Failed to create history file: %v", err)
+	}
+	defer out.Close()
+	if err := h.Write(out); err != nil {
+		glog.Fatalf("Failed to write history file: %v", err)
+	}
+}
+
+func loadHistory() (*history.History, error) {
+	f, err := os.Open(*historyFile)
+	if os.IsNotExist(err) {
+		return history.New(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return history.Read(f)
+}
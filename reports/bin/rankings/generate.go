@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/csv"
+	"github.com/countcraicula/hytek/history"
+	"github.com/countcraicula/hytek/reports"
+	"github.com/golang/glog"
+)
+
+var (
+	resultFiles = flag.String("results", "", "comma separated HY3 result files or patterns, e.g. season/*.hy3")
+	historyFile = flag.String("history", "", "swim history to rank, written by the history tool, instead of result files")
+	title       = flag.String("title", "Season rankings", "")
+	ages        = flag.String("ages", "", "comma separated age groups, e.g. 0-10,11-12,13+; open rankings when empty")
+	club        = flag.String("club", "", "abbreviation of the club to rank, all clubs when empty")
+	from        = flag.String("from", "", "first date of swims to rank, YYYY-MM-DD")
+	to          = flag.String("to", "", "last date of swims to rank, YYYY-MM-DD")
+)
+
+func main() {
+	flag.Parse()
+	h, err := loadHistory()
+	if err != nil {
+		glog.Fatalf("Failed to load results: %v", err)
+	}
+	groups, err := hytek.ParseAgeGroups(*ages)
+	if err != nil {
+		glog.Fatalf("Failed to parse age groups: %v", err)
+	}
+	f := history.RankingFilter{Team: *club}
+	if f.From, err = parseDate(*from); err != nil {
+		glog.Fatalf("Failed to parse from date: %v", err)
+	}
+	if f.To, err = parseDate(*to); err != nil {
+		glog.Fatalf("Failed to parse to date: %v", err)
+	}
+	rankings := h.Rankings(groups, f)
+
+	buf, err := reports.SeasonRankings(*title, rankings)
+	if err != nil {
+		glog.Fatalf("Failed to generate rankings: %v", err)
+	}
+	if err := os.WriteFile("rankings.pdf", buf.Bytes(), 0644); err != nil {
+		glog.Fatalf("Failed to write rankings file: %v", err)
+	}
+	out, err := os.Create("rankings.csv")
+	if err != nil {
+		glog.Fatalf("Failed to create rankings file: %v", err)
+	}
+	defer out.Close()
+	if err := csv.ToRankings(rankings).Write(out); err != nil {
+		glog.Fatalf("Failed to write rankings file: %v", err)
+	}
+}
+
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
+func loadHistory() (*history.History, error) {
+	if *historyFile != "" {
+		f, err := os.Open(*historyFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return history.Read(f)
+	}
+	h := history.New()
+	for _, pattern := range strings.Split(*resultFiles, ",") {
+		if pattern == "" {
+			continue
+		}
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range files {
+			f, err := os.Open(v)
+			if err != nil {
+				return nil, err
+			}
+			file, err := hytek.ParseHY3File(f)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := h.AddMeet(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return h, nil
+}
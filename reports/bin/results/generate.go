@@ -4,16 +4,47 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"time"
+	"path/filepath"
+	"strings"
 
 	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/csv"
+	"github.com/countcraicula/hytek/history"
+	"github.com/countcraicula/hytek/points"
+	"github.com/countcraicula/hytek/records"
 	"github.com/countcraicula/hytek/reports"
+	"github.com/countcraicula/hytek/scoring"
+	"github.com/countcraicula/hytek/standards"
 	"github.com/golang/glog"
 )
 
 var (
-	resultsFile = flag.String("results", "", "")
-	meetFile    = flag.String("meet", "", "")
+	resultsFile   = flag.String("results", "", "")
+	meetFile      = flag.String("meet", "", "")
+	combine       = flag.String("combine", "", "semicolon separated groups of comma separated event numbers swum together, as passed when seeding, e.g. 1A,1B;2A,2B")
+	split         = flag.String("split", "", "semicolon separated events to place by age group, e.g. 1=9-10,11-12,13+")
+	output        = flag.String("output", "", "file to write the placed results to in HY3 format")
+	placePoints   = flag.String("points", "", "comma separated points by place for individual events, e.g. 9,7,6,5,4,3,2,1; when set team standings are written")
+	relayPoints   = flag.String("relay_points", "", "comma separated points by place for relays, defaults to double the individual points")
+	maxScorers    = flag.Int("max_scorers", 0, "most swimmers from a team that score in an event, 0 for no limit")
+	highPoint     = flag.String("high_point_ages", "", "comma separated age groups for high point awards, e.g. 9-10,11-12,13+; when set high point awards are written")
+	hpEvents      = flag.String("high_point_events", "", "comma separated event numbers eligible for high point awards, all events when empty")
+	hpTieBreak    = flag.String("high_point_tie_break", "wins", "comma separated tie breaks for high point awards: wins, places or events")
+	baseTimes     = flag.String("base_times", "", "CSV file of World Aquatics base times; when set results carry points")
+	labels        = flag.Int("labels", 0, "number of places per event to print award labels for; when set labels and a medal table are written")
+	labelLayout   = flag.String("label_layout", "L7163", "award label sheet: L7163 or L7160")
+	certificates  = flag.Bool("certificates", false, "write a participation certificate for each swimmer")
+	certTemplate  = flag.String("certificate_template", "", "JSON file of the certificate title, logo, background and fonts")
+	standardsFile = flag.String("standards", "", "CSV file of time standards; when set the standards achieved for the first time are written")
+	historyFile   = flag.String("history", "", "history CSV written by the history binary; personal bests and standards are judged against it rather than seed times")
+	eventTitle    = flag.String("event_title", reports.DefaultEventTitle, "template of event titles")
+	schedule      = flag.String("schedule", "", "JSON file of the sessions: dates, warm up and start times, event order and breaks")
+	format        = flag.String("format", "pdf", "format of the result sheets: pdf, html or txt")
+	club          = flag.String("club", "", "abbreviation of the club to filter the reports to, all clubs when empty")
+	hyTek         = flag.Bool("hytek_text", false, "also write results in the Meet Manager text layout, with splits and disqualifications")
+	exTeams       = flag.String("exhibition_teams", "", "comma separated abbreviations of teams swimming exhibition, not placed or scored")
+	exSwimmers    = flag.String("exhibition_swimmers", "", "comma separated IDs of swimmers swimming exhibition, not placed or scored")
+	recordFiles   = flag.String("records", "", "comma separated record tables as name:code:file, e.g. Meet:M:meet-records.csv; updated tables are written with an updated- prefix")
 )
 
 func main() {
@@ -35,15 +66,102 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Failed to parse meet file: %v", err)
 	}
-	var opts = []reports.SheetOption{
-		reports.SessionTimesOption([]time.Time{
-			time.Date(2022, 12, 29, 10, 30, 0, 0, time.Local),
-			time.Date(2022, 12, 30, 10, 30, 0, 0, time.Local),
-		}),
+	var opts []reports.SheetOption
+	t, err := reports.ParseEventTitle(*eventTitle)
+	if err != nil {
+		glog.Fatalf("Failed to parse event title: %v", err)
+	}
+	opts = append(opts, reports.EventTitleOption(t), reports.MeetDatesOption(meet))
+	renderer, ok := reports.ParseRenderer(*format)
+	if !ok {
+		glog.Fatalf("Unknown format %q", *format)
 	}
+	opts = append(opts, reports.RendererOption(renderer), reports.ClubOption(*club))
 	if err := hytek.PopulateMeetEntries(meet, file); err != nil {
 		glog.Fatalf("Failed to populate meet entries: %v", err)
 	}
+	for _, group := range strings.Split(*combine, ";") {
+		if group == "" {
+			continue
+		}
+		if _, err := meet.CombineEvents(strings.Split(group, ",")...); err != nil {
+			glog.Fatalf("Failed to combine events: %v", err)
+		}
+	}
+	for _, v := range strings.Split(*split, ";") {
+		if v == "" {
+			continue
+		}
+		ss := strings.SplitN(v, "=", 2)
+		if len(ss) != 2 {
+			glog.Fatalf("Failed to parse split %q", v)
+		}
+		groups, err := hytek.ParseAgeGroups(ss[1])
+		if err != nil {
+			glog.Fatalf("Failed to parse split %q: %v", v, err)
+		}
+		if err := meet.SplitEvent(ss[0], groups...); err != nil {
+			glog.Fatalf("Failed to split event: %v", err)
+		}
+	}
+
+	if *schedule != "" {
+		f, err := os.Open(*schedule)
+		if err != nil {
+			glog.Fatalf("Failed to open schedule: %v", err)
+		}
+		s, err := reports.ReadSchedule(f)
+		f.Close()
+		if err != nil {
+			glog.Fatalf("Failed to parse schedule: %v", err)
+		}
+		scheduleOpts, err := s.Options(meet)
+		if err != nil {
+			glog.Fatalf("Failed to apply schedule: %v", err)
+		}
+		opts = append(opts, scheduleOpts...)
+	}
+
+	meet.MarkExhibition(exhibition(*exTeams, *exSwimmers))
+	for _, event := range meet.Events {
+		event.Place()
+	}
+	var b *points.BaseTimes
+	if *baseTimes != "" {
+		f, err := os.Open(*baseTimes)
+		if err != nil {
+			glog.Fatalf("Failed to open base times file: %v", err)
+		}
+		b, err = points.ParseBaseTimes(f)
+		if err != nil {
+			glog.Fatalf("Failed to parse base times file: %v", err)
+		}
+		opts = append(opts, reports.BaseTimesOption(b))
+	}
+	tables, err := loadRecords()
+	if err != nil {
+		glog.Fatalf("Failed to load records: %v", err)
+	}
+	if len(tables) > 0 {
+		opts = append(opts, reports.RecordsOption(tables...))
+	}
+	h, err := loadHistory()
+	if err != nil {
+		glog.Fatalf("Failed to load history: %v", err)
+	}
+	if h != nil {
+		opts = append(opts, reports.HistoryOption(h))
+	}
+	if *output != "" {
+		out, err := os.Create(*output)
+		if err != nil {
+			glog.Fatalf("Failed to create output file: %v", err)
+		}
+		if err := hytek.GenerateHY3File(file, out); err != nil {
+			glog.Fatalf("Failed to write output file: %v", err)
+		}
+		out.Close()
+	}
 
 	resultsBuf, err := reports.ResultSheet(meet, meet.Events, opts...)
 	if err != nil {
@@ -51,9 +169,325 @@ func main() {
 	}
 
 	for i, v := range resultsBuf {
-		if err := os.WriteFile(fmt.Sprintf("results-%d.pdf", i+1), v.Bytes(), 0644); err != nil {
+		if err := os.WriteFile(fmt.Sprintf("results-%d.%v", i+1, renderer.Extension()), v.Bytes(), 0644); err != nil {
 			glog.Fatalf("Failed to write results file: %v", err)
 		}
 	}
 
+	if *hyTek {
+		textBufs, err := reports.HyTekResults(meet, meet.Events, opts...)
+		if err != nil {
+			glog.Fatalf("Failed to generate text results: %v", err)
+		}
+		for i, v := range textBufs {
+			if err := os.WriteFile(fmt.Sprintf("results-hytek-%d.txt", i+1), v.Bytes(), 0644); err != nil {
+				glog.Fatalf("Failed to write text results file: %v", err)
+			}
+		}
+	}
+
+	announcerBufs, err := reports.AnnouncerScript(meet, meet.Events, opts...)
+	if err != nil {
+		glog.Fatalf("Failed to generate announcer script: %v", err)
+	}
+	for i, v := range announcerBufs {
+		if err := os.WriteFile(fmt.Sprintf("announcer-%d.%v", i+1, renderer.Extension()), v.Bytes(), 0644); err != nil {
+			glog.Fatalf("Failed to write announcer script: %v", err)
+		}
+	}
+
+	if err := updateRecords(meet, tables); err != nil {
+		glog.Fatalf("Failed to update records: %v", err)
+	}
+
+	if *labels > 0 {
+		if err := writeAwards(meet, opts); err != nil {
+			glog.Fatalf("Failed to write awards: %v", err)
+		}
+	}
+
+	if *certificates {
+		if err := writeCertificates(meet, opts); err != nil {
+			glog.Fatalf("Failed to write certificates: %v", err)
+		}
+	}
+
+	if *standardsFile != "" {
+		if err := writeAchievements(meet, h, opts); err != nil {
+			glog.Fatalf("Failed to write standards achieved: %v", err)
+		}
+	}
+
+	if b != nil {
+		perfBuf, err := reports.BestPerformances(meet, meet.Events, opts...)
+		if err != nil {
+			glog.Fatalf("Failed to generate best performances: %v", err)
+		}
+		if err := os.WriteFile("best-performances.pdf", perfBuf.Bytes(), 0644); err != nil {
+			glog.Fatalf("Failed to write best performances file: %v", err)
+		}
+		out, err := os.Create("results.csv")
+		if err != nil {
+			glog.Fatalf("Failed to create results file: %v", err)
+		}
+		defer out.Close()
+		if err := csv.MeetToResultsWithPoints(meet, b).Write(out); err != nil {
+			glog.Fatalf("Failed to write results file: %v", err)
+		}
+	}
+
+	if *placePoints != "" {
+		table, err := scoringTable()
+		if err != nil {
+			glog.Fatalf("Failed to parse points: %v", err)
+		}
+		scores := table.Score(meet.Events)
+		standingsBuf, err := reports.TeamStandings(meet, scores, opts...)
+		if err != nil {
+			glog.Fatalf("Failed to generate team standings: %v", err)
+		}
+		if err := os.WriteFile("standings.pdf", standingsBuf.Bytes(), 0644); err != nil {
+			glog.Fatalf("Failed to write standings file: %v", err)
+		}
+		out, err := os.Create("standings.csv")
+		if err != nil {
+			glog.Fatalf("Failed to create standings file: %v", err)
+		}
+		defer out.Close()
+		if err := csv.ScoresToStandings(scores).Write(out); err != nil {
+			glog.Fatalf("Failed to write standings file: %v", err)
+		}
+
+		if *highPoint != "" {
+			if err := writeHighPoint(meet, scores, opts); err != nil {
+				glog.Fatalf("Failed to write high point awards: %v", err)
+			}
+		}
+	}
+
+}
+
+func loadRecords() ([]*records.Table, error) {
+	var ret []*records.Table
+	for _, v := range strings.Split(*recordFiles, ",") {
+		if v == "" {
+			continue
+		}
+		ss := strings.SplitN(v, ":", 3)
+		if len(ss) != 3 {
+			return nil, fmt.Errorf("invalid record table %q", v)
+		}
+		f, err := os.Open(ss[2])
+		if err != nil {
+			return nil, err
+		}
+		t, err := records.ParseTable(ss[0], ss[1], f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", ss[2], err)
+		}
+		ret = append(ret, t)
+	}
+	return ret, nil
+}
+
+// updateRecords writes each record table updated with the records broken
+// at the meet, once the result sheets have been generated from the
+// original tables.
+func updateRecords(meet *hytek.Meet, tables []*records.Table) error {
+	paths := make(map[string]string)
+	for _, v := range strings.Split(*recordFiles, ",") {
+		if ss := strings.SplitN(v, ":", 3); len(ss) == 3 {
+			paths[ss[0]] = ss[2]
+		}
+	}
+	for _, t := range tables {
+		var breaks []*records.Break
+		for _, event := range meet.Events {
+			breaks = append(breaks, t.Check(meet.CourseCode, event.ResultGroups())...)
+		}
+		for _, b := range breaks {
+			glog.Infof("%v in event %v: %v", b, b.Event.Number, b.Time)
+		}
+		t.Update(breaks, meet.Description, meet.StartDate.Format("2006-01-02"))
+		path := paths[t.Name]
+		out, err := os.Create(filepath.Join(filepath.Dir(path), "updated-"+filepath.Base(path)))
+		if err != nil {
+			return err
+		}
+		if err := t.Write(out); err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAwards(meet *hytek.Meet, opts []reports.SheetOption) error {
+	layouts := map[string]reports.LabelLayout{
+		"L7163": reports.AveryL7163,
+		"L7160": reports.AveryL7160,
+	}
+	layout, ok := layouts[*labelLayout]
+	if !ok {
+		return fmt.Errorf("unknown label layout %q", *labelLayout)
+	}
+	buf, err := reports.AwardLabels(meet, meet.Events, *labels, layout, opts...)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile("labels.pdf", buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	buf, err = reports.MedalTable(meet, scoring.MedalTable(meet.Events), opts...)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("medals.pdf", buf.Bytes(), 0644)
+}
+
+func writeCertificates(meet *hytek.Meet, opts []reports.SheetOption) error {
+	t := reports.DefaultCertificateTemplate
+	if *certTemplate != "" {
+		f, err := os.Open(*certTemplate)
+		if err != nil {
+			return err
+		}
+		t, err = reports.ReadCertificateTemplate(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	buf, err := reports.Certificates(meet, meet.Events, t, opts...)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("certificates.pdf", buf.Bytes(), 0644)
+}
+
+// loadHistory reads the history file, or returns nil when there is none.
+func loadHistory() (*history.History, error) {
+	if *historyFile == "" {
+		return nil, nil
+	}
+	f, err := os.Open(*historyFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return history.Read(f)
+}
+
+func writeAchievements(meet *hytek.Meet, h *history.History, opts []reports.SheetOption) error {
+	f, err := os.Open(*standardsFile)
+	if err != nil {
+		return err
+	}
+	set, err := standards.ParseSet(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	var achievements []*standards.Achievement
+	for _, event := range meet.Events {
+		achievements = append(achievements, set.Achievements(meet.CourseCode, event.ResultGroups(), h, meet.StartDate)...)
+	}
+	buf, err := reports.StandardAchievements(meet, achievements, opts...)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile("standards.pdf", buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	out, err := os.Create("standards.csv")
+	if err != nil {
+		return err
+	}
+	if err := csv.ToAchievements(achievements).Write(out); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// exhibition picks the entries of the teams and swimmers swimming
+// exhibition.
+func exhibition(teams, swimmers string) func(*hytek.Entry) bool {
+	t := make(map[string]bool)
+	for _, v := range strings.Split(teams, ",") {
+		if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+			t[v] = true
+		}
+	}
+	sw := make(map[string]bool)
+	for _, v := range strings.Split(swimmers, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			sw[v] = true
+		}
+	}
+	return func(e *hytek.Entry) bool {
+		if e.Team != nil && e.Team.Name != nil && t[strings.ToUpper(strings.TrimSpace(e.Team.Name.Abbr))] {
+			return true
+		}
+		return e.Swimmer != nil && sw[strings.TrimSpace(e.Swimmer.ID)]
+	}
+}
+
+func scoringTable() (*scoring.Table, error) {
+	individual, err := scoring.ParsePoints(*placePoints)
+	if err != nil {
+		return nil, err
+	}
+	relay := make([]float64, len(individual))
+	for i, v := range individual {
+		relay[i] = v * 2
+	}
+	if *relayPoints != "" {
+		if relay, err = scoring.ParsePoints(*relayPoints); err != nil {
+			return nil, err
+		}
+	}
+	return &scoring.Table{
+		Individual: individual,
+		Relay:      relay,
+		MaxScorers: *maxScorers,
+	}, nil
+}
+
+func writeHighPoint(meet *hytek.Meet, scores scoring.Scores, opts []reports.SheetOption) error {
+	groups, err := hytek.ParseAgeGroups(*highPoint)
+	if err != nil {
+		return err
+	}
+	var eligible func(*hytek.Event) bool
+	if *hpEvents != "" {
+		numbers := make(map[string]bool)
+		for _, v := range strings.Split(*hpEvents, ",") {
+			numbers[strings.TrimSpace(v)] = true
+		}
+		eligible = func(e *hytek.Event) bool { return numbers[e.Number] }
+	}
+	var tieBreaks []scoring.HighPointTieBreak
+	for _, v := range strings.Split(*hpTieBreak, ",") {
+		switch strings.TrimSpace(v) {
+		case "":
+		case "wins":
+			tieBreaks = append(tieBreaks, scoring.MostWins)
+		case "places":
+			tieBreaks = append(tieBreaks, scoring.BestPlaces)
+		case "events":
+			tieBreaks = append(tieBreaks, scoring.FewestEvents)
+		default:
+			return fmt.Errorf("unknown tie break %q", v)
+		}
+	}
+	buf, err := reports.HighPointAwards(meet, scores.HighPoint(groups, eligible, tieBreaks...), opts...)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("high-point.pdf", buf.Bytes(), 0644)
 }
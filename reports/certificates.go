@@ -0,0 +1,197 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// CertificateTemplate is the look of a certificate. Images and fonts are
+// paths to files.
+type CertificateTemplate struct {
+	Title      string `json:"title"`
+	Logo       string `json:"logo,omitempty"`
+	Background string `json:"background,omitempty"`
+	// Font is the font family of the certificate, loaded from FontFile
+	// and BoldFontFile when they are set.
+	Font         string `json:"font,omitempty"`
+	FontFile     string `json:"font_file,omitempty"`
+	BoldFontFile string `json:"bold_font_file,omitempty"`
+}
+
+var DefaultCertificateTemplate = CertificateTemplate{
+	Title: "Certificate of Participation",
+	Font:  consts.Helvetica,
+}
+
+// ReadCertificateTemplate reads a template from JSON. Fields that aren't
+// set keep the defaults.
+func ReadCertificateTemplate(r io.Reader) (CertificateTemplate, error) {
+	t := DefaultCertificateTemplate
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		return CertificateTemplate{}, err
+	}
+	return t, nil
+}
+
+type certificateSwim struct {
+	event *hytek.Event
+	entry *hytek.Entry
+}
+
+type certificate struct {
+	swimmer *hytek.HY3SwimmerInfo1
+	team    *hytek.HY3SwimTeam
+	swims   []*certificateSwim
+}
+
+// certificates collects the swims of each swimmer of the club of the
+// options in event order, sorted by team and swimmer name.
+func certificates(events []*hytek.Event, s *SheetOptions) []*certificate {
+	bySwimmer := make(map[*hytek.HY3SwimmerInfo1]*certificate)
+	var ret []*certificate
+	for _, e := range events {
+		for _, event := range e.ResultGroups() {
+			for _, entry := range event.Entries {
+				if entry.Swimmer == nil || entry.Entry == nil || entry.Entry.Result == nil || !inClub(s, entry) {
+					continue
+				}
+				c, ok := bySwimmer[entry.Swimmer]
+				if !ok {
+					c = &certificate{swimmer: entry.Swimmer, team: entry.Team}
+					bySwimmer[entry.Swimmer] = c
+					ret = append(ret, c)
+				}
+				c.swims = append(c.swims, &certificateSwim{event: event, entry: entry})
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if ta, tb := TeamAbbr(a.team), TeamAbbr(b.team); ta != tb {
+			return ta < tb
+		}
+		if a.swimmer.LastName != b.swimmer.LastName {
+			return a.swimmer.LastName < b.swimmer.LastName
+		}
+		return a.swimmer.FirstName < b.swimmer.FirstName
+	})
+	return ret
+}
+
+// personalBest reports whether the result beat the swimmer's previous best,
+// from the history when it has an earlier swim of the event, or else the
+// seed time. A first swim with no seed time counts.
+func personalBest(m *hytek.Meet, event *hytek.Event, entry *hytek.Entry, s *SheetOptions) bool {
+	r := entry.Entry.Result
+	if !r.Placeable() {
+		return false
+	}
+	if h := s.History(); h != nil && entry.Swimmer != nil {
+		best := h.Best(&history.Swim{
+			ID:       strings.TrimSpace(entry.Swimmer.ID),
+			Course:   r.LengthUnit,
+			Stroke:   event.Stroke,
+			Distance: event.Distance,
+		}, m.StartDate)
+		if best != nil {
+			return r.Time < best.Time
+		}
+	}
+	return entry.Entry.SeedTime1 == 0 || r.Time < entry.Entry.SeedTime1
+}
+
+// Certificates prints a page for each swimmer with their events, times,
+// places and personal bests.
+func Certificates(m *hytek.Meet, events []*hytek.Event, t CertificateTemplate, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	events = s.EventOrder().Sorted(events)
+
+	p := pdf.NewMaroto(s.Orientation(), s.Size())
+	family := t.Font
+	if family == "" {
+		family = consts.Helvetica
+	}
+	if t.FontFile != "" {
+		p.AddUTF8Font(family, consts.Normal, t.FontFile)
+		bold := t.BoldFontFile
+		if bold == "" {
+			bold = t.FontFile
+		}
+		p.AddUTF8Font(family, consts.Bold, bold)
+	}
+	p.SetDefaultFontFamily(family)
+
+	_, height := p.GetPageSize()
+	_, top, _, bottom := p.GetPageMargins()
+	height -= top + bottom
+	var err error
+	for _, c := range certificates(events, s) {
+		p.Row(height, func() {
+			p.Col(12, func() {
+				if t.Background != "" {
+					if e := p.FileImage(t.Background, props.Rect{Percent: 100, Center: true}); e != nil && err == nil {
+						err = e
+					}
+				}
+				if t.Logo != "" {
+					if e := p.FileImage(t.Logo, props.Rect{Percent: 20, Top: 5, Left: 5}); e != nil && err == nil {
+						err = e
+					}
+				}
+				certificatePage(p, m, t, c, s, height)
+			})
+		})
+	}
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+	return p.Output()
+}
+
+// Swim lines start below the heading and are spaced evenly down the page.
+const (
+	certificateSwimsTop = 125
+	certificateSwimLine = 8
+)
+
+func certificatePage(p pdf.Maroto, m *hytek.Meet, t CertificateTemplate, c *certificate, s *SheetOptions, height float64) {
+	p.Text(t.Title, props.Text{Top: 60, Size: 28, Align: consts.Center, Style: consts.Bold})
+	p.Text("presented to", props.Text{Top: 75, Size: 12, Align: consts.Center})
+	p.Text(fmt.Sprintf("%v %v", c.swimmer.FirstName, c.swimmer.LastName), props.Text{Top: 85, Size: 24, Align: consts.Center, Style: consts.Bold})
+	p.Text(teamFullName(c.team), props.Text{Top: 97, Size: 12, Align: consts.Center})
+	p.Text(fmt.Sprintf("%v, %v", m.Description, m.StartDate.Format("2 January 2006")), props.Text{Top: 105, Size: 12, Align: consts.Center})
+	swims := c.swims
+	// Keep to the lines that fit on the page, with the last saying how many
+	// more swims there were.
+	lines := int((height - certificateSwimsTop) / certificateSwimLine)
+	if len(swims) > lines && lines > 0 {
+		swims = swims[:lines-1]
+	}
+	for i, v := range swims {
+		line := fmt.Sprintf("%v   %v", s.EventTitle(m, v.event), resultTime(v.entry))
+		if place := v.entry.Entry.Result.PlaceOverall; place > 0 {
+			line += fmt.Sprintf("   %v", ordinal(place))
+		}
+		if personalBest(m, v.event, v.entry, s) {
+			line += "   PB"
+		}
+		certificateLine(p, i, line)
+	}
+	if more := len(c.swims) - len(swims); more > 0 {
+		certificateLine(p, len(swims), fmt.Sprintf("and %v more swims", more))
+	}
+}
+
+func certificateLine(p pdf.Maroto, i int, line string) {
+	p.Text(line, props.Text{Top: float64(certificateSwimsTop + i*certificateSwimLine), Size: 12, Align: consts.Center})
+}
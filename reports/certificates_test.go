@@ -0,0 +1,47 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+)
+
+func TestPersonalBest(t *testing.T) {
+	h := history.New()
+	if err := h.Add(&history.Swim{ID: "S1", Date: "2026-09-01", Course: "S", Stroke: hytek.Freestyle, Distance: 50, Time: 33}); err != nil {
+		t.Fatal(err)
+	}
+	m := &hytek.Meet{StartDate: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)}
+	event := &hytek.Event{Stroke: hytek.Freestyle, Distance: 50}
+	tests := []struct {
+		name string
+		id   string
+		seed hytek.HY3Time
+		time hytek.HY3Time
+		h    *history.History
+		want bool
+	}{
+		{name: "faster than the history", id: "S1", seed: 30, time: 32, h: h, want: true},
+		{name: "slower than the history", id: "S1", seed: 35, time: 34, h: h, want: false},
+		{name: "seed without history", id: "S1", seed: 30, time: 32, want: false},
+		{name: "seed when missing from the history", id: "S2", seed: 35, time: 34, h: h, want: true},
+		{name: "first swim", id: "S2", time: 40, h: h, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry := &hytek.Entry{
+				Swimmer: &hytek.HY3SwimmerInfo1{ID: test.id},
+				Entry: &hytek.HY3IndividualEventEntryInfo{
+					SeedTime1: test.seed,
+					Result:    &hytek.HY3IndividualEventResults{Time: test.time, LengthUnit: "S"},
+				},
+			}
+			s := applyOptions([]SheetOption{HistoryOption(test.h)})
+			if got := personalBest(m, event, entry, s); got != test.want {
+				t.Errorf("personalBest = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
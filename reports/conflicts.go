@@ -0,0 +1,155 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// Conflict is a swimmer whose next swim starts too soon after they are
+// estimated to finish their previous one.
+type Conflict struct {
+	Swimmer *hytek.HY3SwimmerInfo1
+	First   *hytek.Event
+	Second  *hytek.Event
+	// FirstEntry and SecondEntry are the swimmer's entries in the events.
+	FirstEntry  *hytek.Entry
+	SecondEntry *hytek.Entry
+	// Finish is the estimated finish of the first swim and Start the
+	// estimated start of the heat of the second.
+	Finish time.Time
+	Start  time.Time
+}
+
+func (c *Conflict) Rest() time.Duration {
+	return c.Start.Sub(c.Finish)
+}
+
+type swim struct {
+	event  *hytek.Event
+	entry  *hytek.Entry
+	start  time.Time
+	finish time.Time
+}
+
+// FindConflicts returns the swimmers with less than the minimum rest between
+// consecutive swims, using the estimated timeline of each session.
+func FindConflicts(events []*hytek.Event, opts ...SheetOption) []*Conflict {
+	s := applyOptions(opts)
+	swims := make(map[string][]*swim)
+	for _, t := range SessionTimelines(events, opts...) {
+		for _, et := range t.Events {
+			for _, entry := range et.Event.Entries {
+				if entry.Entry == nil || entry.Entry.Result == nil {
+					continue
+				}
+				start := t.HeatStart(et.Event, entry.Entry.Result.Heat)
+				id := entry.Swimmer.ID
+				swims[id] = append(swims[id], &swim{
+					event:  et.Event,
+					entry:  entry,
+					start:  start,
+					finish: start.Add(swimDuration(et.Event, entry.Entry.SeedTime1, s)),
+				})
+			}
+		}
+	}
+	var ret []*Conflict
+	for _, v := range swims {
+		sort.Slice(v, func(i, j int) bool { return v[i].start.Before(v[j].start) })
+		for i := 1; i < len(v); i++ {
+			prev, next := v[i-1], v[i]
+			if next.start.Sub(prev.finish) >= s.MinRest() {
+				continue
+			}
+			ret = append(ret, &Conflict{
+				Swimmer:     next.entry.Swimmer,
+				First:       prev.event,
+				Second:      next.event,
+				FirstEntry:  prev.entry,
+				SecondEntry: next.entry,
+				Finish:      prev.finish,
+				Start:       next.start,
+			})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Start.Equal(ret[j].Start) {
+			return ret[i].Swimmer.ID < ret[j].Swimmer.ID
+		}
+		return ret[i].Start.Before(ret[j].Start)
+	})
+	return ret
+}
+
+const maxConflictRounds = 10
+
+// ResolveConflicts moves swimmers with too little rest into a later heat of
+// their second event, swapping with a swimmer of a similar seed time, until
+// they have enough rest or there is no one to swap with. It returns the
+// conflicts that remain.
+func ResolveConflicts(events []*hytek.Event, opts ...SheetOption) []*Conflict {
+	s := applyOptions(opts)
+	maxGap := hytek.HY3Time(s.MaxSwapGap().Seconds())
+	conflicts := FindConflicts(events, opts...)
+	// Swimmers already moved, or about to be, aren't swapped back into an
+	// earlier heat.
+	fixed := make(map[*hytek.Entry]bool)
+	isFixed := func(e *hytek.Entry) bool { return fixed[e] }
+	for i := 0; i < maxConflictRounds && len(conflicts) > 0; i++ {
+		for _, c := range conflicts {
+			fixed[c.SecondEntry] = true
+		}
+		moved := false
+		for _, c := range conflicts {
+			if c.Second.MoveToLaterHeat(c.SecondEntry, maxGap, isFixed) {
+				moved = true
+			}
+		}
+		if !moved {
+			break
+		}
+		conflicts = FindConflicts(events, opts...)
+	}
+	return conflicts
+}
+
+// ConflictReport lists the swimmers with too little rest between swims.
+func ConflictReport(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, fmt.Sprintf("Less than %v rest", s.MinRest())},
+		&Column{Name: "Name", Width: 3},
+		&Column{Name: "Finishes", Width: 4},
+		&Column{Name: "Next swim", Width: 4},
+		&Column{Name: "Rest", Width: 1, Align: AlignRight},
+	)
+	for _, c := range FindConflicts(events, opts...) {
+		if inClub(s, c.FirstEntry) {
+			conflictEntry(p, c)
+		}
+	}
+	return p.Output()
+}
+
+func conflictEntry(p pdf.Maroto, c *Conflict) {
+	p.Row(6, func() {
+		p.Col(3, func() {
+			p.Text(fmt.Sprintf("%v, %v", c.Swimmer.LastName, c.Swimmer.FirstName))
+		})
+		p.Col(4, func() {
+			p.Text(fmt.Sprintf("#%v heat %v %v", c.First.Number, c.FirstEntry.Entry.Result.Heat, c.Finish.Format(startTimeFormat)))
+		})
+		p.Col(4, func() {
+			p.Text(fmt.Sprintf("#%v heat %v %v", c.Second.Number, c.SecondEntry.Entry.Result.Heat, c.Start.Format(startTimeFormat)))
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprintf("%.0fs", c.Rest().Seconds()), props.Text{Align: consts.Right})
+		})
+	})
+}
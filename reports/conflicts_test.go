@@ -0,0 +1,34 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestResolveConflicts(t *testing.T) {
+	first := testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30})
+	second := testEvent("2", hytek.Backstroke, 50, []hytek.HY3Time{40}, []hytek.HY3Time{30, 41})
+	for i, id := range []string{"A"} {
+		first.Entries[i].Swimmer = &hytek.HY3SwimmerInfo1{ID: id}
+	}
+	for i, id := range []string{"A", "C", "B"} {
+		second.Entries[i].Swimmer = &hytek.HY3SwimmerInfo1{ID: id}
+	}
+	a, b, c := second.Entries[0], second.Entries[2], second.Entries[1]
+	opts := []SheetOption{MinRestOption(2 * time.Minute)}
+	if got := ResolveConflicts([]*hytek.Event{first, second}, opts...); len(got) != 0 {
+		t.Errorf("%v conflicts remain, want none", len(got))
+	}
+	// A swaps with B, seeded a second apart, rather than C in the same lane.
+	if r := a.Entry.Result; r.Heat != 2 || r.Lane != 2 {
+		t.Errorf("A in heat %v lane %v, want heat 2 lane 2", r.Heat, r.Lane)
+	}
+	if r := b.Entry.Result; r.Heat != 1 || r.Lane != 1 {
+		t.Errorf("B in heat %v lane %v, want heat 1 lane 1", r.Heat, r.Lane)
+	}
+	if r := c.Entry.Result; r.Heat != 2 || r.Lane != 1 {
+		t.Errorf("C in heat %v lane %v, want heat 2 lane 1", r.Heat, r.Lane)
+	}
+}
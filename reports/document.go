@@ -0,0 +1,113 @@
+package reports
+
+import "bytes"
+
+// Document is the layout independent content of a report, rendered to PDF,
+// HTML or text by a Renderer.
+type Document struct {
+	// Header is shown at the top of each page, left to right, e.g. the meet
+	// description, location, session and date.
+	Header []string
+	// Title names the report, e.g. "Heat sheet".
+	Title    string
+	Sections []*Section
+}
+
+// Section is one part of a document, usually an event.
+type Section struct {
+	// Heading, when set, starts the section on a new page and is added to
+	// the title of that and following pages, e.g. "Lane 3".
+	Heading string
+	Title   string
+	// Notes are small print under the title, e.g. records.
+	Notes  []string
+	Tables []*Table
+	// Footer is shown after the tables, e.g. a break.
+	Footer string
+}
+
+// Align is the alignment of a column.
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+)
+
+// Column describes a table column. Widths and spaces are in twelfths of the
+// page width.
+type Column struct {
+	Name string
+	// Space is the gap left before the column.
+	Space uint
+	Width uint
+	Align Align
+	Bold  bool
+}
+
+// Row is a table row with a cell for each column. Note is small print shown
+// under the row.
+type Row struct {
+	Cells []string
+	Note  string
+}
+
+// Table is a block of rows, e.g. a heat.
+type Table struct {
+	// Title and Aside head the table on the left and right, e.g. the heat and
+	// its start time.
+	Title string
+	Aside string
+	// Columns are named in a header row if any has a name.
+	Columns []*Column
+	Rows    []*Row
+	// WriteIn leaves room in each row for writing, e.g. times on lane
+	// sheets.
+	WriteIn bool
+}
+
+// HasColumnNames reports whether the table has a header row.
+func (t *Table) HasColumnNames() bool {
+	for _, c := range t.Columns {
+		if c.Name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Renderer lays out documents in a file format.
+type Renderer interface {
+	Render(d *Document, s *SheetOptions) (bytes.Buffer, error)
+	// Extension is the file name extension of the format, e.g. "pdf".
+	Extension() string
+}
+
+var (
+	PDFRenderer  Renderer = pdfRenderer{}
+	HTMLRenderer Renderer = htmlRenderer{}
+	TextRenderer Renderer = textRenderer{}
+)
+
+// ParseRenderer returns the renderer for the extension: pdf, html or txt.
+func ParseRenderer(ext string) (Renderer, bool) {
+	for _, r := range []Renderer{PDFRenderer, HTMLRenderer, TextRenderer} {
+		if r.Extension() == ext {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// renderDocuments renders each document with the renderer of the options.
+func renderDocuments(docs []*Document, s *SheetOptions) ([]bytes.Buffer, error) {
+	var ret []bytes.Buffer
+	for _, d := range docs {
+		buf, err := s.Renderer().Render(d, s)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, buf)
+	}
+	return ret, nil
+}
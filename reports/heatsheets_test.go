@@ -0,0 +1,39 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestHeatSheetKeepsOrder(t *testing.T) {
+	events := []*hytek.Event{
+		testEvent("2", hytek.Backstroke, 50, []hytek.HY3Time{40, 41}),
+		testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30, 31}, []hytek.HY3Time{35}),
+	}
+	// Seed order, the reverse of the running order.
+	for _, e := range events {
+		for _, entry := range e.Entries {
+			entry.Swimmer = &hytek.HY3SwimmerInfo1{}
+		}
+		for i, j := 0, len(e.Entries)-1; i < j; i, j = i+1, j-1 {
+			e.Entries[i], e.Entries[j] = e.Entries[j], e.Entries[i]
+		}
+	}
+	wantEvents := append([]*hytek.Event(nil), events...)
+	wantEntries := make(map[*hytek.Event]hytek.Entries)
+	for _, e := range events {
+		wantEntries[e] = append(hytek.Entries(nil), e.Entries...)
+	}
+	heatSheet(&hytek.Meet{}, events, applyOptions(nil), 1)
+	for i, e := range events {
+		if e != wantEvents[i] {
+			t.Errorf("event %v is %v, want %v", i, e.Number, wantEvents[i].Number)
+		}
+		for j, entry := range e.Entries {
+			if entry != wantEntries[e][j] {
+				t.Errorf("event %v entry %v moved", e.Number, j)
+			}
+		}
+	}
+}
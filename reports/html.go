@@ -0,0 +1,57 @@
+package reports
+
+import (
+	"bytes"
+	"html/template"
+)
+
+type htmlRenderer struct{}
+
+func (htmlRenderer) Extension() string { return "html" }
+
+var htmlDocument = template.Must(template.New("document").Funcs(template.FuncMap{
+	"right": func(a Align) bool { return a == AlignRight },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; }
+header { display: flex; justify-content: space-between; font-weight: bold; border-bottom: 1px solid; }
+h1 { text-align: center; font-size: 1.2em; }
+section:not(:first-of-type) h2 { page-break-before: always; }
+h3 { margin-bottom: 0.2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { padding: 0.1em 1em 0.1em 0; text-align: left; }
+.right { text-align: right; }
+.bold { font-weight: bold; }
+.note { font-size: small; }
+.write-in td { height: 2.5em; }
+.footer { text-align: center; font-weight: bold; }
+</style>
+</head>
+<body>
+<header>{{range .Header}}<span>{{.}}</span>{{end}}</header>
+<h1>{{.Title}}</h1>
+{{range .Sections}}<section>
+{{if .Heading}}<h2>{{.Heading}}</h2>
+{{end}}{{if .Title}}<h3>{{.Title}}</h3>
+{{end}}{{range .Notes}}<div class="note">{{.}}</div>
+{{end}}{{range .Tables}}<table{{if .WriteIn}} class="write-in"{{end}}>
+{{if or .Title .Aside}}<caption><span class="bold">{{.Title}}</span> {{.Aside}}</caption>
+{{end}}{{if .HasColumnNames}}<tr>{{range .Columns}}<th{{if right .Align}} class="right"{{end}}>{{.Name}}</th>{{end}}</tr>
+{{end}}{{$columns := .Columns}}{{range .Rows}}<tr>{{range $i, $c := .Cells}}{{with index $columns $i}}<td{{if or (right .Align) .Bold}} class="{{if right .Align}}right{{end}}{{if .Bold}} bold{{end}}"{{end}}>{{$c}}</td>{{end}}{{end}}</tr>
+{{if .Note}}<tr><td class="note" colspan="{{len $columns}}">{{.Note}}</td></tr>
+{{end}}{{end}}</table>
+{{end}}{{if .Footer}}<p class="footer">{{.Footer}}</p>
+{{end}}</section>
+{{end}}</body>
+</html>
+`))
+
+func (htmlRenderer) Render(d *Document, s *SheetOptions) (bytes.Buffer, error) {
+	var buf bytes.Buffer
+	err := htmlDocument.Execute(&buf, d)
+	return buf, err
+}
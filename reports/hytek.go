@@ -0,0 +1,211 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+	"github.com/johnfercher/maroto/pkg/consts"
+)
+
+// The classic Meet Manager text layout is 80 characters wide, with the
+// columns below.
+const (
+	hyTekWidth     = 80
+	hyTekNameStart = 6
+	hyTekAgeEnd    = 34
+	hyTekTeamStart = 35
+	hyTekSeedEnd   = 67
+	hyTekFinalEnd  = 80
+	hyTekIndent    = "        "
+)
+
+// HyTekResults writes results in the fixed-width text layout of Meet
+// Manager, with splits and disqualification reasons under each swim.
+func HyTekResults(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
+	s := applyOptions(opts)
+	var ret []bytes.Buffer
+	for i, events := range sessionEvents(events, s) {
+		ret = append(ret, hyTekResults(m, events, s, i+1))
+	}
+	return ret, nil
+}
+
+// HyTekHeatSheet writes heat sheets in the fixed-width text layout of Meet
+// Manager.
+func HyTekHeatSheet(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
+	s := applyOptions(opts)
+	var ret []bytes.Buffer
+	for i, events := range sessionEvents(events, s) {
+		ret = append(ret, hyTekHeatSheet(m, events, s, i+1))
+	}
+	return ret, nil
+}
+
+type hyTekWriter struct {
+	bytes.Buffer
+}
+
+func (w *hyTekWriter) line(l textLine) {
+	w.WriteString(l.String())
+	w.WriteString("\n")
+}
+
+func (w *hyTekWriter) text(v string) {
+	w.WriteString(strings.TrimRight(v, " "))
+	w.WriteString("\n")
+}
+
+func (w *hyTekWriter) centred(v string) {
+	l := newTextLine(hyTekWidth)
+	l.put(0, hyTekWidth, v, consts.Center)
+	w.line(l)
+}
+
+func (w *hyTekWriter) rule() {
+	w.text(strings.Repeat("=", hyTekWidth-1))
+}
+
+func (w *hyTekWriter) meetHeader(m *hytek.Meet, s *SheetOptions, session int, title string) {
+	w.centred(m.Location)
+	w.centred(fmt.Sprintf("%v - %v", m.Description, s.SessionTime(session).Format("02/01/2006")))
+	w.centred(title)
+}
+
+// eventHeader writes the event title, its records and the column headings.
+func (w *hyTekWriter) eventHeader(m *hytek.Meet, event *hytek.Event, s *SheetOptions, first, seed, final string) {
+	w.text("")
+	w.text(s.EventTitle(m, event))
+	w.rule()
+	for _, v := range resultEventRecords(m, event, s) {
+		w.text("    " + v)
+	}
+	l := newTextLine(hyTekWidth)
+	l.put(0, hyTekNameStart, first, consts.Left)
+	l.put(hyTekNameStart, hyTekAgeEnd-4, "Name", consts.Left)
+	l.put(hyTekAgeEnd-4, hyTekAgeEnd, "Age", consts.Right)
+	l.put(hyTekTeamStart, hyTekSeedEnd-11, "Team", consts.Left)
+	l.put(hyTekSeedEnd-11, hyTekSeedEnd, seed, consts.Right)
+	l.put(hyTekSeedEnd, hyTekFinalEnd, final, consts.Right)
+	w.line(l)
+	w.rule()
+}
+
+// hyTekSwimmer lays out the name, age, team and seed of the entry.
+func hyTekSwimmer(entry *hytek.Entry) textLine {
+	l := newTextLine(hyTekWidth)
+	l.put(hyTekNameStart, hyTekAgeEnd-4, fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName), consts.Left)
+	l.put(hyTekAgeEnd-4, hyTekAgeEnd, fmt.Sprint(entry.Swimmer.Age), consts.Right)
+	l.put(hyTekTeamStart, hyTekSeedEnd-11, teamFullName(entry.Team), consts.Left)
+	l.put(hyTekSeedEnd-11, hyTekSeedEnd, entry.Entry.SeedTime1.String(), consts.Right)
+	return l
+}
+
+func hyTekResults(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) bytes.Buffer {
+	w := &hyTekWriter{}
+	w.meetHeader(m, s, session, "Results")
+	for _, e := range s.EventOrder().Sorted(events) {
+		for _, event := range e.ResultGroups() {
+			if len(event.Entries) == 0 || !hasClubEntry(event, s) {
+				continue
+			}
+			w.eventHeader(m, event, s, "", "Seed Time", "Finals Time")
+			breaks := recordBreaks(m, event, s)
+			for _, entry := range placeOrder(event.Entries) {
+				if !inClub(s, entry) {
+					continue
+				}
+				final, _ := recordMarks(breaks[entry])
+				l := hyTekSwimmer(entry)
+				place := resultPlace(entry)
+				l.put(0, hyTekNameStart, strings.TrimSuffix(place, "."), consts.Right)
+				l.put(hyTekSeedEnd, hyTekFinalEnd, resultTime(entry)+strings.Join(final, ""), consts.Right)
+				w.line(l)
+				for _, v := range hyTekSplits(entry.Entry.Result) {
+					w.text(hyTekIndent + v)
+				}
+				if r := entry.Entry.Result; r.Disqualified() && r.DQDescription != nil {
+					w.text(hyTekIndent + hyTekDQReason(r.DQDescription))
+				}
+			}
+		}
+	}
+	return w.Buffer
+}
+
+// hyTekSplits formats the cumulative splits of the swim, each after the
+// first followed by the time of its length in brackets, wrapped to fit the
+// line.
+func hyTekSplits(r *hytek.HY3IndividualEventResults) []string {
+	if r == nil {
+		return nil
+	}
+	var ret []string
+	var line []string
+	var prev hytek.HY3Time
+	for _, v := range r.Splits {
+		for _, t := range v.Times {
+			if t.Time == 0 {
+				continue
+			}
+			split := fmt.Sprintf("%9v", t.Time)
+			if prev != 0 {
+				split += fmt.Sprintf(" (%v)", t.Time-prev)
+			}
+			prev = t.Time
+			if n := len(hyTekIndent) + len(strings.Join(append(line, split), " ")); n > hyTekWidth-1 {
+				ret = append(ret, strings.Join(line, " "))
+				line = nil
+			}
+			line = append(line, split)
+		}
+	}
+	if len(line) > 0 {
+		ret = append(ret, strings.Join(line, " "))
+	}
+	return ret
+}
+
+func hyTekDQReason(d *hytek.HY3DQDescription) string {
+	reason := strings.TrimSpace(d.Description)
+	if reason == "" {
+		return fmt.Sprintf("DQ code %v", strings.TrimSpace(d.Code))
+	}
+	return reason
+}
+
+func hyTekHeatSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) bytes.Buffer {
+	w := &hyTekWriter{}
+	w.meetHeader(m, s, session, "Meet Program")
+	events, t := runningOrder(events, s, session)
+	for _, event := range events {
+		if len(event.Entries) == 0 || !hasClubEntry(event, s) {
+			continue
+		}
+		w.eventHeader(m, event, s, "Lane", "Seed Time", "")
+		entries := heatOrder(event.Entries)
+		heats := 0
+		if len(entries) > 0 {
+			heats = entries[len(entries)-1].Entry.Result.Heat
+		}
+		heat := 0
+		for _, entry := range entries {
+			if !inClub(s, entry) {
+				continue
+			}
+			if entry.Entry.Result.Heat != heat {
+				heat = entry.Entry.Result.Heat
+				w.text(fmt.Sprintf("Heat %v of %v  Timed Finals  Starts at %v", heat, heats, t.HeatStart(event, heat).Format(startTimeFormat)))
+			}
+			l := hyTekSwimmer(entry)
+			l.put(0, hyTekNameStart, fmt.Sprint(entry.Entry.Result.Lane), consts.Right)
+			w.line(l)
+		}
+		if et := t.Event(event); et != nil && et.Break != 0 {
+			w.text("")
+			w.centred(fmt.Sprintf("%v minute break", int(et.Break.Minutes())))
+		}
+	}
+	return w.Buffer
+}
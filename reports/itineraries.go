@@ -0,0 +1,207 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// ItinerarySwim is a heat a swimmer is seeded in, with its estimated start
+// and length.
+type ItinerarySwim struct {
+	Session  int
+	Event    *hytek.Event
+	Entry    *hytek.Entry
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Itinerary is the swims of one swimmer in the order they are swum.
+type Itinerary struct {
+	Swimmer *hytek.HY3SwimmerInfo1
+	Team    *hytek.HY3SwimTeam
+	Swims   []*ItinerarySwim
+}
+
+// teamSwims lists the swims of the team's swimmers in the seeded events in
+// the order they are swum.
+func teamSwims(team *hytek.HY3SwimTeam, events []*hytek.Event, s *SheetOptions) []*ItinerarySwim {
+	var ret []*ItinerarySwim
+	abbr := TeamAbbr(team)
+	o := s.EventOrder()
+	for i, events := range o.SplitBySession(events) {
+		session := i + 1
+		events, t := runningOrder(events, s, session)
+		for _, event := range events {
+			for _, entry := range heatOrder(event.Entries) {
+				if !strings.EqualFold(TeamAbbr(entry.Team), abbr) {
+					continue
+				}
+				swim := &ItinerarySwim{
+					Session: session,
+					Event:   event,
+					Entry:   entry,
+					Start:   t.HeatStart(event, entry.Entry.Result.Heat),
+				}
+				if h := t.Heat(event, entry.Entry.Result.Heat); h != nil {
+					swim.Duration = h.Duration
+				}
+				ret = append(ret, swim)
+			}
+		}
+	}
+	return ret
+}
+
+// Itineraries returns the itinerary of each swimmer of the team with a swim
+// in the events, which must be seeded.
+func Itineraries(team *hytek.HY3SwimTeam, events []*hytek.Event, opts ...SheetOption) []*Itinerary {
+	s := applyOptions(opts)
+	bySwimmer := make(map[*hytek.HY3SwimmerInfo1][]*ItinerarySwim)
+	for _, swim := range teamSwims(team, events, s) {
+		bySwimmer[swim.Entry.Swimmer] = append(bySwimmer[swim.Entry.Swimmer], swim)
+	}
+	var ret []*Itinerary
+	for _, swimmer := range team.Swimmers {
+		if swims := bySwimmer[swimmer.Info1]; len(swims) > 0 {
+			ret = append(ret, &Itinerary{Swimmer: swimmer.Info1, Team: team, Swims: swims})
+		}
+	}
+	return ret
+}
+
+// ItineraryPDF lists the swims of the itinerary with their heat, lane and
+// estimated start.
+func ItineraryPDF(m *hytek.Meet, it *Itinerary, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "Itinerary"})
+	p.Row(12, func() {
+		p.Col(12, func() {
+			p.Text(fmt.Sprintf("%v %v", it.Swimmer.FirstName, it.Swimmer.LastName), props.Text{Top: 3, Size: 16, Style: consts.Bold})
+		})
+	})
+	p.Row(8, func() {
+		p.Col(12, func() {
+			p.Text(teamFullName(it.Team))
+		})
+	})
+	session := 0
+	for _, swim := range it.Swims {
+		if swim.Session != session {
+			session = swim.Session
+			itinerarySessionHeader(p, s, session)
+		}
+		itineraryEntry(p, s.EventTitle(m, swim.Event), swim)
+	}
+	return p.Output()
+}
+
+func itinerarySessionHeader(p pdf.Maroto, s *SheetOptions, session int) {
+	title := fmt.Sprintf("Session %v - %v", session, s.SessionTime(session).Format("Monday 02/01/2006"))
+	if w := s.WarmUpTime(session); !w.IsZero() {
+		title += fmt.Sprintf(" (warm up %v)", w.Format(startTimeFormat))
+	}
+	p.Row(6, func() {})
+	p.Row(8, func() {
+		p.Col(12, func() {
+			p.Text(title, props.Text{Style: consts.Bold})
+		})
+	})
+	p.Row(6, func() {
+		p.Col(2, func() {
+			p.Text("About", props.Text{Style: consts.Bold})
+		})
+		p.Col(6, func() {
+			p.Text("Event", props.Text{Style: consts.Bold})
+		})
+		p.Col(1, func() {
+			p.Text("Heat", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+		p.Col(1, func() {
+			p.Text("Lane", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+		p.Col(2, func() {
+			p.Text("Seed", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+	})
+	p.Line(1.0)
+}
+
+func itineraryEntry(p pdf.Maroto, title string, swim *ItinerarySwim) {
+	p.Row(7, func() {
+		p.Col(2, func() {
+			p.Text(swim.Start.Format(startTimeFormat))
+		})
+		p.Col(6, func() {
+			p.Text(title, props.Text{Size: 9})
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprint(swim.Entry.Entry.Result.Heat), props.Text{Align: consts.Right})
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprint(swim.Entry.Entry.Result.Lane), props.Text{Align: consts.Right})
+		})
+		p.Col(2, func() {
+			p.Text(swim.Entry.Entry.SeedTime1.String(), props.Text{Align: consts.Right})
+		})
+	})
+}
+
+const icsTimeFormat = "20060102T150405Z"
+
+// icsEscape escapes an iCalendar text value.
+var icsEscape = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// icsWriter writes iCalendar content lines, folded at 75 octets and ended
+// with CRLF.
+type icsWriter struct {
+	bytes.Buffer
+}
+
+func (w *icsWriter) line(name, value string) {
+	l := name + ":" + value
+	// Folded lines start with a space, which counts towards their length.
+	for n := 75; len(l) > n; n = 74 {
+		// Don't split a UTF-8 sequence.
+		for n > 0 && l[n]&0xC0 == 0x80 {
+			n--
+		}
+		w.WriteString(l[:n] + "\r\n ")
+		l = l[n:]
+	}
+	w.WriteString(l + "\r\n")
+}
+
+// ItineraryICS writes the itinerary as an iCalendar file with an event for
+// each swim at its estimated start, at the meet location.
+func ItineraryICS(m *hytek.Meet, it *Itinerary, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	w := &icsWriter{}
+	stamp := time.Now().UTC().Format(icsTimeFormat)
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//countcraicula//hytek reports//EN")
+	w.line("X-WR-CALNAME", icsEscape.Replace(fmt.Sprintf("%v %v - %v", it.Swimmer.FirstName, it.Swimmer.LastName, m.Description)))
+	for _, swim := range it.Swims {
+		r := swim.Entry.Entry.Result
+		end := swim.Start.Add(swim.Duration)
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", fmt.Sprintf("%v-%v-%v-%v@hytek", swim.Start.UTC().Format("20060102"), strings.TrimSpace(swim.Event.Number), r.Heat, r.Lane))
+		w.line("DTSTAMP", stamp)
+		w.line("DTSTART", swim.Start.UTC().Format(icsTimeFormat))
+		w.line("DTEND", end.UTC().Format(icsTimeFormat))
+		w.line("SUMMARY", icsEscape.Replace(fmt.Sprintf("%v - Heat %v, Lane %v", s.EventTitle(m, swim.Event), r.Heat, r.Lane)))
+		w.line("LOCATION", icsEscape.Replace(m.Location))
+		w.line("DESCRIPTION", icsEscape.Replace(fmt.Sprintf("%v %v\nSeed time %v\nEstimated start %v, times may change on the day.",
+			it.Swimmer.FirstName, it.Swimmer.LastName, swim.Entry.Entry.SeedTime1, swim.Start.Format(startTimeFormat))))
+		w.line("END", "VEVENT")
+	}
+	w.line("END", "VCALENDAR")
+	return w.Buffer, nil
+}
@@ -0,0 +1,55 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICSLineFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		lines int
+	}{
+		{name: "short", value: "VCALENDAR", lines: 1},
+		{name: "exactly 75 octets", value: strings.Repeat("a", 67), lines: 1},
+		{name: "76 octets", value: strings.Repeat("a", 68), lines: 2},
+		{name: "long", value: strings.Repeat("abcdefghij", 20), lines: 3},
+		{name: "multi-byte at the fold", value: strings.Repeat("a", 66) + strings.Repeat("é", 40), lines: 3},
+		{name: "multi-byte throughout", value: strings.Repeat("€", 60), lines: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &icsWriter{}
+			w.line("SUMMARY", test.value)
+			out := w.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("%q doesn't end with CRLF", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(lines) != test.lines {
+				t.Errorf("got %v lines, want %v", len(lines), test.lines)
+			}
+			var unfolded strings.Builder
+			for i, l := range lines {
+				if len(l) > 75 {
+					t.Errorf("line %v is %v octets, want at most 75", i, len(l))
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("line %v %q splits a UTF-8 sequence", i, l)
+				}
+				if i > 0 {
+					if !strings.HasPrefix(l, " ") {
+						t.Errorf("folded line %v %q doesn't start with a space", i, l)
+					}
+					l = l[1:]
+				}
+				unfolded.WriteString(l)
+			}
+			if got, want := unfolded.String(), "SUMMARY:"+test.value; got != want {
+				t.Errorf("unfolded %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/scoring"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// LabelLayout is the size and position of the labels on a sheet, in mm.
+// Columns must divide 12.
+type LabelLayout struct {
+	Columns int
+	Height  float64
+	// Pitch is the distance from the left of one column of labels to the
+	// left of the next, and Gutter the gap between the columns.
+	Pitch  float64
+	Gutter float64
+	// Left is the margin to the left of the first column of labels.
+	Left   float64
+	Top    float64
+	Bottom float64
+}
+
+// Avery L7163 is 14 labels of 99.1x38.1mm on an A4 sheet.
+var AveryL7163 = LabelLayout{Columns: 2, Height: 38.1, Pitch: 101.6, Gutter: 2.5, Left: 4.65, Top: 15.1, Bottom: 15.1}
+
+// Avery L7160 is 21 labels of 63.5x38.1mm on an A4 sheet.
+var AveryL7160 = LabelLayout{Columns: 3, Height: 38.1, Pitch: 66.0, Gutter: 2.5, Left: 7.25, Top: 15.1, Bottom: 15.1}
+
+// margins returns the page margins that make each column of the grid span a
+// pitch, from the middle of the gutter before the label to the middle of the
+// gutter after it, so that centred text lands in the middle of the label.
+func (l LabelLayout) margins(pageWidth float64) (left, right float64) {
+	left = l.Left - l.Gutter/2
+	return left, pageWidth - left - float64(l.Columns)*l.Pitch
+}
+
+type awardLabel struct {
+	event *hytek.Event
+	entry *hytek.Entry
+}
+
+// AwardLabels prints a label for each swimmer placed in the top n of each
+// event, or of each age group of events placed by age group.
+func AwardLabels(m *hytek.Meet, events []*hytek.Event, n int, layout LabelLayout, opts ...SheetOption) (bytes.Buffer, error) {
+	if layout.Columns <= 0 || 12%layout.Columns != 0 {
+		return bytes.Buffer{}, fmt.Errorf("labels can't be laid out in %v columns", layout.Columns)
+	}
+	s := applyOptions(opts)
+	var labels []*awardLabel
+	for _, e := range s.EventOrder().Sorted(events) {
+		for _, event := range e.ResultGroups() {
+			for _, entry := range placeOrder(event.Entries) {
+				if entry.Swimmer == nil || entry.Entry == nil || entry.Entry.Result == nil || !inClub(s, entry) {
+					continue
+				}
+				if place := entry.Entry.Result.PlaceOverall; place >= 1 && place <= n {
+					labels = append(labels, &awardLabel{event: event, entry: entry})
+				}
+			}
+		}
+	}
+
+	p := pdf.NewMaroto(consts.Portrait, s.Size())
+	pageWidth, _ := p.GetPageSize()
+	left, right := layout.margins(pageWidth)
+	p.SetPageMargins(left, layout.Top, right)
+	// Maroto keeps a 20mm bottom margin, too much to fit the last row of
+	// labels.
+	if mp, ok := p.(*pdf.PdfMaroto); ok {
+		mp.Pdf.SetAutoPageBreak(true, layout.Bottom)
+	}
+	width := uint(12 / layout.Columns)
+	for i := 0; i < len(labels); i += layout.Columns {
+		p.Row(layout.Height, func() {
+			for j := i; j < i+layout.Columns; j++ {
+				if j >= len(labels) {
+					p.ColSpace(width)
+					continue
+				}
+				awardLabelCol(p, m, labels[j], width, s)
+			}
+		})
+	}
+	return p.Output()
+}
+
+func awardLabelCol(p pdf.Maroto, m *hytek.Meet, l *awardLabel, width uint, s *SheetOptions) {
+	team := ""
+	if l.entry.Team != nil && l.entry.Team.Name != nil {
+		team = l.entry.Team.Name.Name
+	}
+	p.Col(width, func() {
+		p.Text(m.Description, props.Text{Top: 3, Size: 8, Align: consts.Center, Style: consts.Bold})
+		p.Text(
+			s.EventTitle(m, l.event),
+			props.Text{Top: 8, Size: 8, Align: consts.Center})
+		p.Text(fmt.Sprintf("%v Place", ordinal(l.entry.Entry.Result.PlaceOverall)), props.Text{Top: 14, Size: 12, Align: consts.Center, Style: consts.Bold})
+		p.Text(fmt.Sprintf("%v %v", l.entry.Swimmer.FirstName, l.entry.Swimmer.LastName), props.Text{Top: 21, Size: 10, Align: consts.Center})
+		p.Text(team, props.Text{Top: 26, Size: 8, Align: consts.Center})
+		p.Text(l.entry.Entry.Result.Time.String(), props.Text{Top: 31, Size: 8, Align: consts.Center})
+	})
+}
+
+// ordinal returns the place as 1st, 2nd, 3rd and so on.
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%v%v", n, suffix)
+}
+
+// MedalTable reports the gold, silver and bronze medals won by each team.
+func MedalTable(m *hytek.Meet, medals []*scoring.Medals, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "Medal table"},
+		&Column{Name: "Team", Space: 1, Width: 2},
+		&Column{Width: 4},
+		&Column{Name: "Gold", Width: 1, Align: AlignRight},
+		&Column{Name: "Silver", Width: 1, Align: AlignRight},
+		&Column{Name: "Bronze", Width: 1, Align: AlignRight},
+		&Column{Name: "Total", Width: 1, Align: AlignRight},
+	)
+	for _, v := range medals {
+		v := v
+		p.Row(6, func() {
+			p.Col(1, func() {
+				p.Text(fmt.Sprintf("%v.", v.Place), props.Text{Align: consts.Right})
+			})
+			p.Col(2, func() {
+				if v.Team.Name != nil {
+					p.Text(v.Team.Name.Abbr)
+				}
+			})
+			p.Col(4, func() {
+				if v.Team.Name != nil {
+					p.Text(v.Team.Name.Name)
+				}
+			})
+			for _, n := range []int{v.Gold, v.Silver, v.Bronze, v.Total()} {
+				n := n
+				p.Col(1, func() {
+					p.Text(fmt.Sprint(n), props.Text{Align: consts.Right})
+				})
+			}
+		})
+	}
+	return p.Output()
+}
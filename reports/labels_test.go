@@ -0,0 +1,34 @@
+package reports
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLabelLayoutMargins(t *testing.T) {
+	const a4Width = 210.0
+	tests := []struct {
+		name   string
+		layout LabelLayout
+		width  float64
+	}{
+		{"L7163", AveryL7163, 99.1},
+		{"L7160", AveryL7160, 63.5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := test.layout
+			left, right := l.margins(a4Width)
+			if math.Abs(left-right) > 0.01 {
+				t.Errorf("margins %v and %v, want the labels centred on the sheet", left, right)
+			}
+			column := (a4Width - left - right) / float64(l.Columns)
+			for i := 0; i < l.Columns; i++ {
+				label := l.Left + float64(i)*l.Pitch + test.width/2
+				if got := left + (float64(i)+0.5)*column; math.Abs(got-label) > 0.01 {
+					t.Errorf("column %v centred at %.2fmm, label at %.2fmm", i+1, got, label)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+)
+
+const checkBox = "[ ]"
+
+// MarshallingSheet lists each heat in running order for the call room, with
+// a box to tick as each swimmer reports, followed by a tick list of every
+// swim by swimmer name for chasing missing swimmers.
+func MarshallingSheet(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
+	s := applyOptions(opts)
+	var docs []*Document
+	for i, events := range sessionEvents(events, s) {
+		docs = append(docs, marshallingSheet(m, events, s, i+1))
+	}
+	return renderDocuments(docs, s)
+}
+
+type marshalledSwim struct {
+	event *hytek.Event
+	entry *hytek.Entry
+}
+
+func marshallingSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) *Document {
+	d := &Document{
+		Header: sessionHeader(m, s, session),
+		Title:  "Marshalling sheet",
+	}
+	events, t := runningOrder(events, s, session)
+	var swims []*marshalledSwim
+	for _, event := range events {
+		if len(event.Entries) == 0 {
+			continue
+		}
+		entries := heatOrder(event.Entries)
+		heats := 0
+		if len(entries) > 0 {
+			heats = entries[len(entries)-1].Entry.Result.Heat
+		}
+		section := &Section{Title: s.EventTitle(m, event)}
+		var table *Table
+		heat := 0
+		for _, entry := range entries {
+			if !inClub(s, entry) {
+				continue
+			}
+			if entry.Entry.Result.Heat != heat || table == nil {
+				heat = entry.Entry.Result.Heat
+				table = &Table{
+					Title:   fmt.Sprintf("Heat %v of %v", heat, heats),
+					Aside:   fmt.Sprintf("Starts about %v", t.HeatStart(event, heat).Format(startTimeFormat)),
+					Columns: marshallingColumns,
+				}
+				section.Tables = append(section.Tables, table)
+			}
+			table.Rows = append(table.Rows, &Row{Cells: []string{
+				fmt.Sprint(entry.Entry.Result.Lane),
+				fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName),
+				fmt.Sprint(entry.Swimmer.Age),
+				TeamAbbr(entry.Team),
+				entry.Entry.SeedTime1.String(),
+				checkBox,
+			}})
+			swims = append(swims, &marshalledSwim{event: event, entry: entry})
+		}
+		if len(section.Tables) > 0 {
+			d.Sections = append(d.Sections, section)
+		}
+	}
+	if len(swims) > 0 {
+		d.Sections = append(d.Sections, missingSwimmers(swims))
+	}
+	return d
+}
+
+var marshallingColumns = []*Column{
+	{Width: 1, Align: AlignRight},
+	{Space: 1, Width: 4},
+	{Width: 1},
+	{Width: 2},
+	{Width: 2, Align: AlignRight},
+	{Width: 1, Align: AlignRight},
+}
+
+// missingSwimmers lists the swims by swimmer name, in running order for
+// each swimmer.
+func missingSwimmers(swims []*marshalledSwim) *Section {
+	sort.SliceStable(swims, func(i, j int) bool {
+		a, b := swims[i].entry.Swimmer, swims[j].entry.Swimmer
+		if a.LastName != b.LastName {
+			return a.LastName < b.LastName
+		}
+		return a.FirstName < b.FirstName
+	})
+	table := &Table{Columns: []*Column{
+		{Name: "Name", Width: 4},
+		{Name: "Team", Width: 2},
+		{Name: "Event", Width: 2},
+		{Name: "Heat", Width: 1, Align: AlignRight},
+		{Name: "Lane", Width: 1, Align: AlignRight},
+		{Name: "Missing", Width: 2, Align: AlignRight},
+	}}
+	for _, v := range swims {
+		table.Rows = append(table.Rows, &Row{Cells: []string{
+			fmt.Sprintf("%v, %v", v.entry.Swimmer.LastName, v.entry.Swimmer.FirstName),
+			TeamAbbr(v.entry.Team),
+			strings.TrimSpace(v.event.Number),
+			fmt.Sprint(v.entry.Entry.Result.Heat),
+			fmt.Sprint(v.entry.Entry.Result.Lane),
+			checkBox,
+		}})
+	}
+	return &Section{
+		Heading: "Missing swimmers",
+		Title:   "Tick swimmers who haven't reported by their heat",
+		Tables:  []*Table{table},
+	}
+}
@@ -1,8 +1,14 @@
 package reports
 
 import (
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+	"github.com/countcraicula/hytek/points"
+	"github.com/countcraicula/hytek/records"
 	"github.com/johnfercher/maroto/pkg/consts"
 )
 
@@ -12,7 +18,21 @@ type SheetOptions struct {
 	numLanes     int
 	eventOrder   []OrderFunc
 	sessionTimes []time.Time
+	warmUpTimes  []time.Time
 	bySession    bool
+	startGap     time.Duration
+	changeover   time.Duration
+	breakLength  time.Duration
+	noTimePace   time.Duration
+	minRest      time.Duration
+	maxSwapGap   time.Duration
+	baseTimes    *points.BaseTimes
+	records      []*records.Table
+	eventTitle   *template.Template
+	renderer     Renderer
+	club         string
+	history      *history.History
+	meet         *hytek.Meet
 }
 
 func (s *SheetOptions) Size() consts.PageSize {
@@ -45,18 +65,74 @@ func (s *SheetOptions) EventOrder() *Order {
 	return NewOrder(s.eventOrder...)
 }
 
+// SessionTime returns when the session starts: the time from
+// SessionTimesOption, else a day of the meet from MeetDatesOption, else now.
 func (s *SheetOptions) SessionTime(session int) time.Time {
-	if s == nil || len(s.sessionTimes) < session {
+	switch {
+	case s == nil:
 		return time.Now()
+	case len(s.sessionTimes) >= session:
+		return s.sessionTimes[session-1]
+	case s.meet != nil:
+		return meetSessionTime(s.meet, session, s.EventOrder().Sessions())
 	}
-	return s.sessionTimes[session-1]
+	return time.Now()
 }
 
 func (s *SheetOptions) SessionTimes() []time.Time {
-	if s == nil || len(s.sessionTimes) == 0 {
+	switch {
+	case s == nil:
 		return []time.Time{time.Now()}
+	case len(s.sessionTimes) > 0:
+		return s.sessionTimes
+	case s.meet != nil:
+		var ret []time.Time
+		for i := 1; i <= s.EventOrder().Sessions(); i++ {
+			ret = append(ret, s.SessionTime(i))
+		}
+		return ret
 	}
-	return s.sessionTimes
+	return []time.Time{time.Now()}
+}
+
+// DefaultSessionStart is the time of day sessions start when the meet has
+// no schedule, and DefaultSessionGap the time between the starts of
+// sessions on the same day.
+const (
+	DefaultSessionStart = 10 * time.Hour
+	DefaultSessionGap   = 4 * time.Hour
+)
+
+// meetSessionTime spreads the sessions evenly over the days of the meet, in
+// order, starting at DefaultSessionStart.
+func meetSessionTime(m *hytek.Meet, session, sessions int) time.Time {
+	start := m.StartDate
+	if start.IsZero() {
+		start = time.Now()
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	days := 1
+	if !m.EndDate.IsZero() && m.EndDate.After(start) {
+		days = int(m.EndDate.Sub(start).Hours()/24) + 1
+	}
+	if sessions < session {
+		sessions = session
+	}
+	day := func(i int) int { return i * days / sessions }
+	n := 0
+	for i := session - 2; i >= 0 && day(i) == day(session-1); i-- {
+		n++
+	}
+	return start.AddDate(0, 0, day(session-1)).Add(DefaultSessionStart + time.Duration(n)*DefaultSessionGap)
+}
+
+// WarmUpTime returns when warm up starts before the session, or zero if it
+// isn't known.
+func (s *SheetOptions) WarmUpTime(session int) time.Time {
+	if s == nil || len(s.warmUpTimes) < session {
+		return time.Time{}
+	}
+	return s.warmUpTimes[session-1]
 }
 
 func (s *SheetOptions) BySession() bool {
@@ -66,6 +142,125 @@ func (s *SheetOptions) BySession() bool {
 	return s.bySession
 }
 
+const (
+	defaultStartGap    = 30 * time.Second
+	defaultChangeover  = 60 * time.Second
+	defaultBreakLength = 10 * time.Minute
+	defaultNoTimePace  = 30 * time.Second
+	defaultMinRest     = 5 * time.Minute
+	defaultMaxSwapGap  = 2 * time.Second
+)
+
+// StartGap is the time allowed between the end of one heat and the start of
+// the next.
+func (s *SheetOptions) StartGap() time.Duration {
+	if s == nil || s.startGap == 0 {
+		return defaultStartGap
+	}
+	return s.startGap
+}
+
+// ChangeoverGap is the time allowed between the end of one event and the
+// start of the next.
+func (s *SheetOptions) ChangeoverGap() time.Duration {
+	if s == nil || s.changeover == 0 {
+		return defaultChangeover
+	}
+	return s.changeover
+}
+
+func (s *SheetOptions) BreakDuration() time.Duration {
+	if s == nil || s.breakLength == 0 {
+		return defaultBreakLength
+	}
+	return s.breakLength
+}
+
+// NoTimePace is the time per 25m allowed for swimmers without a seed time.
+func (s *SheetOptions) NoTimePace() time.Duration {
+	if s == nil || s.noTimePace == 0 {
+		return defaultNoTimePace
+	}
+	return s.noTimePace
+}
+
+// MinRest is the least time a swimmer should have between finishing one
+// swim and the start of their next.
+func (s *SheetOptions) MinRest() time.Duration {
+	if s == nil || s.minRest == 0 {
+		return defaultMinRest
+	}
+	return s.minRest
+}
+
+// MaxSwapGap is the most the seed times of two swimmers may differ for them
+// to swap heats when resolving conflicts.
+func (s *SheetOptions) MaxSwapGap() time.Duration {
+	if s == nil || s.maxSwapGap == 0 {
+		return defaultMaxSwapGap
+	}
+	return s.maxSwapGap
+}
+
+// BaseTimes is the table World Aquatics points are calculated from, or nil
+// if points aren't shown.
+func (s *SheetOptions) BaseTimes() *points.BaseTimes {
+	if s == nil {
+		return nil
+	}
+	return s.baseTimes
+}
+
+// Records are the record tables results are checked against.
+func (s *SheetOptions) Records() []*records.Table {
+	if s == nil {
+		return nil
+	}
+	return s.records
+}
+
+// EventTitle renders the title of the event with the event title template.
+func (s *SheetOptions) EventTitle(m *hytek.Meet, e *hytek.Event) string {
+	return s.courseEventTitle(m.CourseCode, e)
+}
+
+// courseEventTitle renders the title of an event swum in the course, for
+// reports without a meet.
+func (s *SheetOptions) courseEventTitle(course hytek.CourseCode, e *hytek.Event) string {
+	var t *template.Template
+	if s != nil {
+		t = s.eventTitle
+	}
+	return FormatEventTitle(t, course, e)
+}
+
+// Renderer lays out heat, lane, psych and result sheets, PDFRenderer by
+// default.
+func (s *SheetOptions) Renderer() Renderer {
+	if s == nil || s.renderer == nil {
+		return PDFRenderer
+	}
+	return s.renderer
+}
+
+// Club is the abbreviation of the club reports are filtered to, or empty
+// for all clubs.
+func (s *SheetOptions) Club() string {
+	if s == nil {
+		return ""
+	}
+	return s.club
+}
+
+// History is the swimmers' history personal bests are judged against, or
+// nil to judge them against seed times.
+func (s *SheetOptions) History() *history.History {
+	if s == nil {
+		return nil
+	}
+	return s.history
+}
+
 type SheetOption func(*SheetOptions)
 
 func SizeOption(size consts.PageSize) SheetOption {
@@ -98,12 +293,103 @@ func SessionTimesOption(sessions []time.Time) SheetOption {
 	})
 }
 
+func WarmUpTimesOption(warmUps []time.Time) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.warmUpTimes = warmUps
+	})
+}
+
 func BySessionOption(b bool) SheetOption {
 	return SheetOption(func(s *SheetOptions) {
 		s.bySession = b
 	})
 }
 
+func StartGapOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.startGap = d
+	})
+}
+
+func ChangeoverGapOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.changeover = d
+	})
+}
+
+func BreakDurationOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.breakLength = d
+	})
+}
+
+func NoTimePaceOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.noTimePace = d
+	})
+}
+
+func MinRestOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.minRest = d
+	})
+}
+
+func MaxSwapGapOption(d time.Duration) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.maxSwapGap = d
+	})
+}
+
+func BaseTimesOption(b *points.BaseTimes) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.baseTimes = b
+	})
+}
+
+func RecordsOption(tables ...*records.Table) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.records = tables
+	})
+}
+
+// EventTitleOption sets the template of event titles, see ParseEventTitle.
+func EventTitleOption(t *template.Template) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.eventTitle = t
+	})
+}
+
+func RendererOption(r Renderer) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.renderer = r
+	})
+}
+
+// ClubOption filters reports to the swimmers of the club with the
+// abbreviation. Heat times and places are still those of the whole meet.
+func ClubOption(abbr string) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.club = strings.TrimSpace(abbr)
+	})
+}
+
+// HistoryOption judges personal bests against the swimmers' history, falling
+// back on seed times for events missing from it.
+func HistoryOption(h *history.History) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.history = h
+	})
+}
+
+// MeetDatesOption starts sessions without a time from SessionTimesOption on
+// the days of the meet, at DefaultSessionStart.
+func MeetDatesOption(m *hytek.Meet) SheetOption {
+	return SheetOption(func(s *SheetOptions) {
+		s.meet = m
+	})
+}
+
 func applyOptions(opts []SheetOption) *SheetOptions {
 	s := &SheetOptions{}
 	for _, opt := range opts {
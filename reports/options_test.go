@@ -0,0 +1,52 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestMeetSessionTime(t *testing.T) {
+	day := func(d int, clock time.Duration) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.Local).Add(clock)
+	}
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		sessions int
+		want     []time.Time
+	}{
+		{
+			name:     "a session a day",
+			start:    day(17, 0),
+			end:      day(18, 0),
+			sessions: 2,
+			want:     []time.Time{day(17, 10*time.Hour), day(18, 10*time.Hour)},
+		},
+		{
+			name:     "two sessions a day",
+			start:    day(17, 0),
+			end:      day(18, 0),
+			sessions: 4,
+			want:     []time.Time{day(17, 10*time.Hour), day(17, 14*time.Hour), day(18, 10*time.Hour), day(18, 14*time.Hour)},
+		},
+		{
+			name:     "no end date",
+			start:    day(17, 0),
+			sessions: 2,
+			want:     []time.Time{day(17, 10*time.Hour), day(17, 14*time.Hour)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &hytek.Meet{StartDate: test.start, EndDate: test.end}
+			for i, want := range test.want {
+				if got := meetSessionTime(m, i+1, test.sessions); !got.Equal(want) {
+					t.Errorf("session %v starts %v, want %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/countcraicula/hytek/history"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// PersonalBests lists the personal bests swum at a meet by team and
+// swimmer, with the improvement on the previous best.
+func PersonalBests(meet string, improvements []*history.Improvement, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	pbs := history.PBs(improvements)
+	sort.SliceStable(pbs, func(i, j int) bool {
+		a, b := pbs[i].Swim, pbs[j].Swim
+		switch {
+		case a.Team != b.Team:
+			return a.Team < b.Team
+		case a.LastName != b.LastName:
+			return a.LastName < b.LastName
+		case a.FirstName != b.FirstName:
+			return a.FirstName < b.FirstName
+		}
+		return a.ID < b.ID
+	})
+	p := newPDFReport(s, []string{meet, "Personal bests"},
+		&Column{Name: "Event", Width: 5},
+		&Column{Name: "Time", Width: 2, Align: AlignRight},
+		&Column{Name: "Previous", Width: 2, Align: AlignRight},
+		&Column{Name: "Faster", Width: 2, Align: AlignRight},
+		&Column{Name: "%", Width: 1, Align: AlignRight},
+	)
+	for i, v := range pbs {
+		if i == 0 || v.Swim.Team != pbs[i-1].Swim.Team {
+			p.Row(6, func() {})
+			p.Row(8, func() {
+				p.Col(12, func() {
+					p.Text(v.Swim.Team, props.Text{Style: consts.Bold})
+				})
+			})
+		}
+		if i == 0 || v.Swim.ID != pbs[i-1].Swim.ID {
+			p.Row(6, func() {
+				p.Col(12, func() {
+					p.Text(fmt.Sprintf("%v, %v (%v)", v.Swim.LastName, v.Swim.FirstName, v.Swim.Age), props.Text{Style: consts.Bold})
+				})
+			})
+		}
+		pbEntry(p, s.courseEventTitle(resultCourses[v.Swim.Course], v.Event), v)
+	}
+	return p.Output()
+}
+
+func pbEntry(p pdf.Maroto, title string, v *history.Improvement) {
+	p.Row(6, func() {
+		p.Col(5, func() {
+			p.Text(title, props.Text{Size: 8})
+		})
+		p.Col(2, func() {
+			p.Text(v.Swim.Time.String(), props.Text{Align: consts.Right})
+		})
+		if v.First() {
+			p.Col(2, func() {
+				p.Text("First swim", props.Text{Align: consts.Right})
+			})
+			return
+		}
+		p.Col(2, func() {
+			p.Text(v.Previous.String(), props.Text{Align: consts.Right})
+		})
+		p.Col(2, func() {
+			p.Text(fmt.Sprintf("-%v", v.Delta()), props.Text{Align: consts.Right})
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprintf("%.1f%%", v.Percent()), props.Text{Align: consts.Right})
+		})
+	})
+}
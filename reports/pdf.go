@@ -0,0 +1,275 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+type pdfRenderer struct{}
+
+func (pdfRenderer) Extension() string { return "pdf" }
+
+const (
+	pdfFooterHeight  = 11
+	pdfTitleHeight   = 8
+	pdfNoteHeight    = 5
+	pdfRowHeight     = 6
+	pdfWriteInHeight = 10
+)
+
+func (pdfRenderer) Render(d *Document, s *SheetOptions) (bytes.Buffer, error) {
+	p := pdf.NewMaroto(s.Orientation(), s.Size())
+	p.SetAliasNbPages("{nb}")
+	p.SetFirstPageNb(1)
+	p.SetDefaultFontFamily(consts.Courier)
+	page := &pdfPage{Maroto: p}
+	heading := ""
+	p.RegisterHeader(func() {
+		pdfPageHeader(p, d, heading)
+		page.top = p.GetCurrentOffset()
+	})
+	pdfPageFooter(p)
+	started := false
+	for _, section := range d.Sections {
+		if section.Heading != "" {
+			// The header of the new page is drawn by AddPage.
+			heading = section.Heading
+			if started {
+				p.AddPage()
+			}
+		}
+		pdfSection(page, section)
+		started = true
+	}
+	return p.Output()
+}
+
+// pdfPage is a document being drawn, with the offset below the page header
+// where the content of each page starts.
+type pdfPage struct {
+	pdf.Maroto
+	top float64
+}
+
+func pdfPageHeader(p pdf.Maroto, d *Document, heading string) {
+	if len(d.Header) > 0 {
+		pdfHeaderRow(p, 6, d.Header)
+		p.Line(1.0)
+	}
+	title := d.Title
+	if heading != "" {
+		title = fmt.Sprintf("%v - %v", title, heading)
+	}
+	p.Row(6, func() {
+		p.Col(12, func() {
+			p.Text(title, props.Text{Align: consts.Center, Style: consts.Bold})
+		})
+	})
+	p.Line(1.0)
+}
+
+// newPDFReport starts a report drawn straight onto the page, with the header
+// across the top of each page above any column names and the page number at
+// the bottom.
+func newPDFReport(s *SheetOptions, header []string, columns ...*Column) pdf.Maroto {
+	p := pdf.NewMaroto(s.Orientation(), s.Size())
+	p.SetAliasNbPages("{nb}")
+	p.SetFirstPageNb(1)
+	p.SetDefaultFontFamily(consts.Courier)
+	p.RegisterHeader(func() {
+		pdfHeaderRow(p, 10, header)
+		p.Line(1.0)
+		if len(columns) > 0 {
+			names := make([]string, len(columns))
+			for i, c := range columns {
+				names[i] = c.Name
+			}
+			pdfRow(p, columns, names, pdfRowHeight, true)
+			p.Line(1.0)
+		}
+	})
+	pdfPageFooter(p)
+	return p
+}
+
+// pdfHeaderRow spreads the header across the page, the first on the left,
+// the last on the right and the rest centred.
+func pdfHeaderRow(p pdf.Maroto, height float64, header []string) {
+	width := uint(12 / len(header))
+	p.Row(height, func() {
+		for i, v := range header {
+			align := consts.Center
+			switch i {
+			case 0:
+				align = consts.Left
+			case len(header) - 1:
+				align = consts.Right
+			}
+			v := v
+			p.Col(width, func() {
+				p.Text(v, props.Text{Align: align, Style: consts.Bold})
+			})
+		}
+	})
+}
+
+func pdfPageFooter(p pdf.Maroto) {
+	p.RegisterFooter(func() {
+		p.Line(1.0)
+		p.Row(10, func() {
+			p.Col(12, func() {
+				p.Text(fmt.Sprintf("Page %v/{nb}", p.GetCurrentPage()), props.Text{Align: consts.Center})
+			})
+		})
+	})
+}
+
+func pdfDistanceFromBottom(p pdf.Maroto) float64 {
+	_, h := p.GetPageSize()
+	_, _, _, b := p.GetPageMargins()
+	return h - b - pdfFooterHeight - p.GetCurrentOffset()
+}
+
+// pdfMaybeAddPage starts a new page if there isn't room for h below the
+// current row. Nothing taller than a page is moved, nor anything at the top
+// of a page, as either would leave a blank page behind.
+func pdfMaybeAddPage(p *pdfPage, h float64) {
+	offset := p.GetCurrentOffset()
+	if offset <= p.top || pdfDistanceFromBottom(p)+offset-p.top < h {
+		return
+	}
+	if pdfDistanceFromBottom(p)+1 < h {
+		p.AddPage()
+	}
+}
+
+func pdfTableHeight(t *Table) float64 {
+	h := 0.0
+	if t.Title != "" || t.Aside != "" {
+		h += pdfRowHeight
+	}
+	if t.HasColumnNames() {
+		h += pdfRowHeight
+	}
+	for _, r := range t.Rows {
+		if t.WriteIn {
+			h += pdfWriteInHeight
+		} else {
+			h += pdfRowHeight
+		}
+		if r.Note != "" {
+			h += pdfNoteHeight
+		}
+	}
+	return h
+}
+
+// pdfSection keeps the section title with its first table.
+func pdfSection(p *pdfPage, section *Section) {
+	h := pdfRowHeight + pdfTitleHeight + pdfNoteHeight*float64(len(section.Notes))
+	if len(section.Tables) > 0 {
+		h += pdfTableHeight(section.Tables[0])
+	}
+	pdfMaybeAddPage(p, h)
+	p.Row(pdfRowHeight, func() {})
+	if section.Title != "" {
+		p.Row(pdfTitleHeight, func() {
+			p.Col(12, func() {
+				p.Text(section.Title, props.Text{Style: consts.Bold})
+			})
+		})
+	}
+	for _, v := range section.Notes {
+		v := v
+		p.Row(pdfNoteHeight, func() {
+			p.Col(12, func() {
+				p.Text(v, props.Text{Size: 8})
+			})
+		})
+	}
+	p.Line(1.0)
+	for i, t := range section.Tables {
+		if i > 0 {
+			pdfMaybeAddPage(p, pdfTableHeight(t))
+		}
+		pdfTable(p, t)
+	}
+	if section.Footer != "" {
+		p.Line(1.0)
+		p.Row(pdfRowHeight, func() {
+			p.Col(12, func() {
+				p.Text(section.Footer, props.Text{Style: consts.Bold, Align: consts.Center})
+			})
+		})
+		p.Line(1.0)
+	}
+}
+
+func pdfAlign(a Align) consts.Align {
+	if a == AlignRight {
+		return consts.Right
+	}
+	return consts.Left
+}
+
+func pdfTable(p pdf.Maroto, t *Table) {
+	if t.Title != "" || t.Aside != "" {
+		p.Row(pdfRowHeight, func() {
+			p.Col(6, func() {
+				p.Text(t.Title, props.Text{Style: consts.Bold})
+			})
+			p.Col(6, func() {
+				p.Text(t.Aside, props.Text{Align: consts.Right, Style: consts.Bold})
+			})
+		})
+	}
+	if t.HasColumnNames() {
+		names := make([]string, len(t.Columns))
+		for i, c := range t.Columns {
+			names[i] = c.Name
+		}
+		pdfRow(p, t.Columns, names, pdfRowHeight, true)
+	}
+	height := float64(pdfRowHeight)
+	if t.WriteIn {
+		height = pdfWriteInHeight
+	}
+	for _, r := range t.Rows {
+		pdfRow(p, t.Columns, r.Cells, height, false)
+		if r.Note != "" {
+			note := r.Note
+			p.Row(pdfNoteHeight, func() {
+				p.ColSpace(2)
+				p.Col(10, func() {
+					p.Text(note, props.Text{Size: 8})
+				})
+			})
+		}
+	}
+}
+
+func pdfRow(p pdf.Maroto, columns []*Column, cells []string, height float64, bold bool) {
+	p.Row(height, func() {
+		for i, c := range columns {
+			if c.Space > 0 {
+				p.ColSpace(c.Space)
+			}
+			text := ""
+			if i < len(cells) {
+				text = cells[i]
+			}
+			style := consts.Normal
+			if bold || c.Bold {
+				style = consts.Bold
+			}
+			align := pdfAlign(c.Align)
+			p.Col(c.Width, func() {
+				p.Text(text, props.Text{Align: align, Style: style})
+			})
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func testSection(heading string, rows int) *Section {
+	table := &Table{Columns: []*Column{{Name: "Name", Width: 12}}}
+	for i := 0; i < rows; i++ {
+		table.Rows = append(table.Rows, &Row{Cells: []string{fmt.Sprint(i)}})
+	}
+	return &Section{Heading: heading, Title: fmt.Sprintf("%v rows", rows), Tables: []*Table{table}}
+}
+
+func pdfPages(t *testing.T, d *Document) int {
+	t.Helper()
+	buf, err := PDFRenderer.Render(d, applyOptions(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Count(buf.Bytes(), []byte("/Type /Page\n"))
+}
+
+func TestPDFPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []*Section
+		want     int
+	}{
+		{
+			name:     "taller than a page flows on from the first page",
+			sections: []*Section{testSection("", 45)},
+			want:     2,
+		},
+		{
+			name:     "taller than two pages",
+			sections: []*Section{testSection("", 100)},
+			want:     3,
+		},
+		{
+			name:     "taller than a page flows on after another section",
+			sections: []*Section{testSection("", 10), testSection("", 45)},
+			want:     2,
+		},
+		{
+			name:     "sections that fit on a page are kept together",
+			sections: []*Section{testSection("", 10), testSection("", 30), testSection("", 30)},
+			want:     3,
+		},
+		{
+			name:     "heading section taller than a page",
+			sections: []*Section{testSection("", 10), testSection("Missing swimmers", 60)},
+			want:     3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &Document{Header: []string{"Meet", "Pool"}, Title: "Heat sheet", Sections: test.sections}
+			if got := pdfPages(t, d); got != test.want {
+				t.Errorf("got %v pages, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/countcraicula/hytek"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+type performance struct {
+	event  *hytek.Event
+	entry  *hytek.Entry
+	points int
+}
+
+// BestPerformances ranks every result of the meet by World Aquatics points.
+// It requires BaseTimesOption.
+func BestPerformances(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	b := s.BaseTimes()
+	if b == nil {
+		return bytes.Buffer{}, fmt.Errorf("no base times to calculate points from")
+	}
+	var ret []*performance
+	for _, event := range events {
+		for _, entry := range event.Entries {
+			if !inClub(s, entry) {
+				continue
+			}
+			if points := b.EntryPoints(m.CourseCode, event, entry); points > 0 {
+				ret = append(ret, &performance{event: event, entry: entry, points: points})
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].points > ret[j].points })
+
+	p := newPDFReport(s, []string{m.Description, m.Location, "Best performances"},
+		&Column{Name: "Rank", Width: 1},
+		&Column{Name: "Name", Width: 3},
+		&Column{Name: "Age", Width: 1},
+		&Column{Name: "Event", Width: 4},
+		&Column{Name: "Time", Width: 2, Align: AlignRight},
+		&Column{Name: "Pts", Width: 1, Align: AlignRight},
+	)
+	rank := 0
+	for i, v := range ret {
+		if i == 0 || v.points != ret[i-1].points {
+			rank = i + 1
+		}
+		performanceEntry(p, s.EventTitle(m, v.event), v, rank)
+	}
+	return p.Output()
+}
+
+func performanceEntry(p pdf.Maroto, title string, v *performance, rank int) {
+	p.Row(6, func() {
+		p.Col(1, func() {
+			p.Text(fmt.Sprintf("%v.", rank), props.Text{Align: consts.Right})
+		})
+		p.Col(3, func() {
+			p.Text(fmt.Sprintf("%v, %v", v.entry.Swimmer.LastName, v.entry.Swimmer.FirstName))
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprint(v.entry.Swimmer.Age))
+		})
+		p.Col(4, func() {
+			p.Text(title, props.Text{Size: 8})
+		})
+		p.Col(2, func() {
+			p.Text(v.entry.Entry.Result.Time.String(), props.Text{Align: consts.Right})
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprint(v.points), props.Text{Align: consts.Right})
+		})
+	})
+}
@@ -5,119 +5,57 @@ import (
 	"fmt"
 
 	"github.com/countcraicula/hytek"
-	"github.com/johnfercher/maroto/pkg/consts"
-	"github.com/johnfercher/maroto/pkg/pdf"
-	"github.com/johnfercher/maroto/pkg/props"
 )
 
 func PsychSheet(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
-	var ret []bytes.Buffer
 	s := applyOptions(opts)
-	eventList := [][]*hytek.Event{events}
-	if s.BySession() {
-		eventList = s.EventOrder().SplitBySession(events)
+	var docs []*Document
+	for i, events := range sessionEvents(events, s) {
+		docs = append(docs, psychSheet(m, events, s, i+1))
 	}
-	for i, events := range eventList {
-		buf, err := psychSheet(m, events, s, i+1)
-		if err != nil {
-			return nil, err
-		}
-		ret = append(ret, buf)
-	}
-	return ret, nil
+	return renderDocuments(docs, s)
 }
 
-func psychSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) (bytes.Buffer, error) {
-	p := pdf.NewMaroto(s.Orientation(), s.Size())
-	p.SetAliasNbPages("{nb}")
-	p.SetFirstPageNb(1)
-	p.SetDefaultFontFamily(consts.Courier)
-	p.RegisterHeader(psychHeader(p, m, s, session))
-	p.RegisterFooter(psychFooter(p))
-	o := s.EventOrder()
-	o.Sort(events)
-	for _, event := range events {
+func psychSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) *Document {
+	d := &Document{
+		Header: sessionHeader(m, s, session),
+		Title:  "Psych sheet",
+	}
+	for _, event := range s.EventOrder().Sorted(events) {
 		if len(event.Entries) == 0 {
 			continue
 		}
-		psychEventHeader(p, event)
+		table := &Table{Columns: psychColumns}
 		for i, entry := range event.Entries {
-			psychEntry(p, entry, i+1)
+			if inClub(s, entry) {
+				table.Rows = append(table.Rows, psychEntry(entry, i+1))
+			}
 		}
-	}
-	return p.Output()
-}
-
-func psychHeader(p pdf.Maroto, m *hytek.Meet, s *SheetOptions, session int) func() {
-	return func() {
-		p.Row(10, func() {
-			p.Col(3, func() {
-				p.Text(m.Description)
-
-			})
-			p.Col(3, func() {
-				p.Text(m.Location, props.Text{Align: consts.Center})
-			})
-			p.Col(3, func() {
-				p.Text(fmt.Sprintf("Session %v", session), props.Text{Align: consts.Center, Style: consts.Bold})
-			})
-			p.Col(3, func() {
-				p.Text(s.SessionTime(session).Format("02/01/2006"), props.Text{Align: consts.Right})
-			})
-		})
-		p.Line(1.0)
-		p.Row(10, func() {
-			p.Col(12, func() {
-				p.Text("Psych sheet", props.Text{Align: consts.Center})
-			})
-		})
-		p.Line(1.0)
-	}
-}
-
-func psychFooter(p pdf.Maroto) func() {
-
-	return func() {
-		p.Line(1.0)
-		p.Row(10, func() {
-			p.Col(12, func() {
-				p.Text(fmt.Sprintf("Page %v/{nb}", p.GetCurrentPage()), props.Text{Align: consts.Center})
-			})
+		if len(table.Rows) == 0 {
+			continue
+		}
+		d.Sections = append(d.Sections, &Section{
+			Title:  s.EventTitle(m, event),
+			Tables: []*Table{table},
 		})
 	}
+	return d
 }
 
-func psychEventHeader(p pdf.Maroto, event *hytek.Event) {
-	p.Row(10, func() {
-		p.Col(3, func() {
-			p.Text(
-				fmt.Sprintf("Mixed %v+", event.MinAge),
-				props.Text{Style: consts.Bold})
-		})
-		p.Col(3, func() {
-			p.Text(
-				fmt.Sprintf("%vm %v", event.Distance, event.Stroke.Display()),
-				props.Text{Style: consts.Bold})
-		})
-	})
-	p.Line(1.0)
+var psychColumns = []*Column{
+	{Width: 1, Align: AlignRight},
+	{Space: 1, Width: 4},
+	{Width: 1},
+	{Width: 2},
+	{Width: 3, Align: AlignRight},
 }
 
-func psychEntry(p pdf.Maroto, entry *hytek.Entry, place int) {
-
-	p.Row(6, func() {
-		p.Col(1, func() {
-			p.Text(fmt.Sprintf("%v.", place), props.Text{Align: consts.Right})
-		})
-		p.ColSpace(1)
-		p.Col(5, func() {
-			p.Text(fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName))
-		})
-		p.Col(2, func() {
-			p.Text(fmt.Sprint(entry.Swimmer.Age))
-		})
-		p.Col(3, func() {
-			p.Text(fmt.Sprintf("%v", entry.Entry.SeedTime1), props.Text{Align: consts.Right})
-		})
-	})
+func psychEntry(entry *hytek.Entry, place int) *Row {
+	return &Row{Cells: []string{
+		fmt.Sprintf("%v.", place),
+		fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName),
+		fmt.Sprint(entry.Swimmer.Age),
+		TeamAbbr(entry.Team),
+		fmt.Sprintf("%v", entry.Entry.SeedTime1),
+	}}
 }
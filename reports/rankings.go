@@ -0,0 +1,57 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/countcraicula/hytek/history"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// SeasonRankings lists the rankings of a season, one table per course,
+// gender, age group, stroke and distance.
+func SeasonRankings(title string, rankings []*history.Ranking, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{title, "Rankings"})
+	for _, r := range rankings {
+		p.Row(6, func() {})
+		p.Row(8, func() {
+			p.Col(12, func() {
+				p.Text(
+					fmt.Sprintf("%v %v %v %v %v", r.Gender.Display(), r.AgeGroup, r.Distance, courseTitles[resultCourses[r.Course]], r.Stroke.Display()),
+					props.Text{Style: consts.Bold})
+			})
+		})
+		p.Line(1.0)
+		for _, v := range r.Swims {
+			rankingEntry(p, v)
+		}
+	}
+	return p.Output()
+}
+
+func rankingEntry(p pdf.Maroto, v *history.Ranked) {
+	p.Row(6, func() {
+		p.Col(1, func() {
+			p.Text(fmt.Sprintf("%v.", v.Rank), props.Text{Align: consts.Right})
+		})
+		p.Col(3, func() {
+			p.Text(fmt.Sprintf("%v, %v", v.Swim.LastName, v.Swim.FirstName))
+		})
+		p.Col(1, func() {
+			p.Text(fmt.Sprint(v.Swim.Age))
+		})
+		p.Col(1, func() {
+			p.Text(v.Swim.Team)
+		})
+		p.Col(2, func() {
+			p.Text(v.Swim.Time.String(), props.Text{Align: consts.Right})
+		})
+		p.ColSpace(1)
+		p.Col(3, func() {
+			p.Text(fmt.Sprintf("%v %v", v.Swim.Date, v.Swim.Meet), props.Text{Size: 8})
+		})
+	})
+}
@@ -3,157 +3,160 @@ package reports
 import (
 	"bytes"
 	"fmt"
-	"sort"
+	"strings"
 
 	"github.com/countcraicula/hytek"
-	"github.com/johnfercher/maroto/pkg/consts"
-	"github.com/johnfercher/maroto/pkg/pdf"
-	"github.com/johnfercher/maroto/pkg/props"
+	"github.com/countcraicula/hytek/records"
 )
 
 func ResultSheet(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) ([]bytes.Buffer, error) {
-	var ret []bytes.Buffer
 	s := applyOptions(opts)
-	eventList := [][]*hytek.Event{events}
-	if s.BySession() {
-		eventList = s.EventOrder().SplitBySession(events)
+	var docs []*Document
+	for i, events := range sessionEvents(events, s) {
+		docs = append(docs, resultSheet(m, events, s, i+1))
 	}
-	for i, events := range eventList {
-		buf, err := resultSheet(m, events, s, i+1)
-		if err != nil {
-			return nil, err
-		}
-		ret = append(ret, buf)
-	}
-	return ret, nil
+	return renderDocuments(docs, s)
 }
 
-func resultSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) (bytes.Buffer, error) {
-	p := pdf.NewMaroto(s.Orientation(), s.Size())
-	p.SetAliasNbPages("{nb}")
-	p.SetFirstPageNb(1)
-	p.SetDefaultFontFamily(consts.Courier)
-	p.RegisterHeader(resultHeader(p, m, s, session))
-	p.RegisterFooter(resultFooter(p))
-	o := s.EventOrder()
-	o.Sort(events)
-	for _, event := range events {
-		if len(event.Entries) == 0 {
-			continue
-		}
-		sort.Slice(event.Entries, func(i, j int) bool {
-			if event.Entries[i].Entry.Result.Time == 0 {
-				return false
+func resultSheet(m *hytek.Meet, events []*hytek.Event, s *SheetOptions, session int) *Document {
+	d := &Document{
+		Header: sessionHeader(m, s, session),
+		Title:  "Result sheet",
+	}
+	for _, e := range s.EventOrder().Sorted(events) {
+		for _, event := range e.ResultGroups() {
+			if len(event.Entries) == 0 {
+				continue
 			}
-			if event.Entries[j].Entry.Result.Time == 0 {
-				return true
+			table := &Table{Columns: resultColumns(s)}
+			breaks := recordBreaks(m, event, s)
+			for _, entry := range placeOrder(event.Entries) {
+				if inClub(s, entry) {
+					table.Rows = append(table.Rows, resultEntry(m, event, entry, s, breaks[entry]))
+				}
 			}
-			return event.Entries[i].Entry.Result.Time < event.Entries[j].Entry.Result.Time
-		})
-		resultEventHeader(p, event)
-		for i, entry := range event.Entries {
-			resultEntry(p, entry, i+1)
+			if len(table.Rows) == 0 {
+				continue
+			}
+			d.Sections = append(d.Sections, &Section{
+				Title:  s.EventTitle(m, event),
+				Notes:  resultEventRecords(m, event, s),
+				Tables: []*Table{table},
+			})
 		}
 	}
-	return p.Output()
+	return d
 }
 
-func resultHeader(p pdf.Maroto, m *hytek.Meet, s *SheetOptions, session int) func() {
-	return func() {
-		p.Row(10, func() {
-			p.Col(3, func() {
-				p.Text(m.Description)
-
-			})
-			p.Col(3, func() {
-				p.Text(m.Location, props.Text{Align: consts.Center})
-			})
-			p.Col(3, func() {
-				p.Text(fmt.Sprintf("Session %v", session), props.Text{Align: consts.Center, Style: consts.Bold})
-			})
-			p.Col(3, func() {
-				p.Text(s.SessionTime(session).Format("02/01/2006"), props.Text{Align: consts.Right})
-			})
-		})
-		p.Line(1.0)
-		p.Row(10, func() {
-			p.Col(12, func() {
-				p.Text("Result sheet", props.Text{Align: consts.Center})
-			})
-		})
-		p.Line(1.0)
+// recordBreaks checks the results of the event against the record tables
+// of the options.
+func recordBreaks(m *hytek.Meet, event *hytek.Event, s *SheetOptions) map[*hytek.Entry][]*records.Break {
+	ret := make(map[*hytek.Entry][]*records.Break)
+	for _, t := range s.Records() {
+		for _, b := range t.Check(m.CourseCode, []*hytek.Event{event}) {
+			ret[b.Entry] = append(ret[b.Entry], b)
+		}
 	}
+	return ret
 }
 
-func resultFooter(p pdf.Maroto) func() {
+// resultEventRecords lists the records of the event.
+func resultEventRecords(m *hytek.Meet, event *hytek.Event, s *SheetOptions) []string {
+	var ret []string
+	for _, t := range s.Records() {
+		for _, r := range t.EventRecords(m.CourseCode, event) {
+			if r.Time == 0 {
+				continue
+			}
+			ret = append(ret, fmt.Sprintf("%v: %v %v  %v  %v %v %v", t.Code, r.Gender.Display(), r.AgeGroup(), r.Time, r.Holder, r.Team, r.Date))
+		}
+	}
+	return ret
+}
 
-	return func() {
-		p.Line(1.0)
-		p.Row(10, func() {
-			p.Col(12, func() {
-				p.Text(fmt.Sprintf("Page %v/{nb}", p.GetCurrentPage()), props.Text{Align: consts.Center})
-			})
-		})
+// resultColumns adds a column for record marks and one for points if the
+// options have records or base times, narrowing the name to fit.
+func resultColumns(s *SheetOptions) []*Column {
+	name := &Column{Name: "Name", Space: 1, Width: 4}
+	switch {
+	case len(s.Records()) > 0 && s.BaseTimes() != nil:
+		name.Space, name.Width = 0, 3
+	case len(s.Records()) > 0 || s.BaseTimes() != nil:
+		name.Width = 3
+	}
+	ret := []*Column{
+		{Name: "Place", Width: 1, Align: AlignRight},
+		name,
+		{Name: "Age", Width: 1},
+		{Name: "Team", Width: 1},
+		{Name: "Result", Width: 2, Align: AlignRight},
+	}
+	if len(s.Records()) > 0 {
+		ret = append(ret, &Column{Width: 1, Bold: true})
+	}
+	ret = append(ret, &Column{Name: "Entry Time", Width: 2, Align: AlignRight})
+	if s.BaseTimes() != nil {
+		ret = append(ret, &Column{Name: "Pts", Width: 1, Align: AlignRight})
 	}
+	return ret
 }
 
-func resultEventHeader(p pdf.Maroto, event *hytek.Event) {
-	p.Row(10, func() {
-		p.Col(3, func() {
-			p.Text(
-				fmt.Sprintf("Mixed %v+", event.MinAge),
-				props.Text{Style: consts.Bold})
-		})
-		p.Col(3, func() {
-			p.Text(
-				fmt.Sprintf("%vm %v", event.Distance, event.Stroke.Display()),
-				props.Text{Style: consts.Bold})
-		})
-	})
-	p.Line(1.0)
-	p.Row(6, func() {
+func resultPlace(entry *hytek.Entry) string {
+	r := entry.Entry.Result
+	if r == nil || r.PlaceOverall == 0 {
+		return "--"
+	}
+	return fmt.Sprintf("%v.", r.PlaceOverall)
+}
 
-		p.Col(1, func() {
-			p.Text("Place", props.Text{Style: consts.Bold})
-		})
-		p.ColSpace(1)
-		p.Col(4, func() {
-			p.Text("Name", props.Text{Style: consts.Bold})
-		})
-		p.Col(1, func() {
-			p.Text("Age", props.Text{Style: consts.Bold})
-		})
-		p.Col(2, func() {
-			p.Text("Result", props.Text{Align: consts.Right, Style: consts.Bold})
-		})
-		p.Col(2, func() {
-			p.Text("Entry Time", props.Text{Align: consts.Right, Style: consts.Bold})
-		})
-	})
+func resultTime(entry *hytek.Entry) string {
+	r := entry.Entry.Result
+	switch {
+	case r.Disqualified():
+		return "DQ"
+	case !r.Placeable():
+		return "NS"
+	case entry.Exhibition:
+		return fmt.Sprintf("x%v", r.Time)
+	}
+	return r.Time.String()
 }
 
-func resultEntry(p pdf.Maroto, entry *hytek.Entry, place int) {
+// recordMarks returns the marks of the records broken by the final time
+// and those broken by splits.
+func recordMarks(breaks []*records.Break) (final, splits []string) {
+	for _, b := range breaks {
+		if b.Split {
+			splits = append(splits, fmt.Sprintf("%vm %v %v", b.Record.Distance, b.Time, b.Mark()))
+			continue
+		}
+		final = append(final, b.Mark())
+	}
+	return final, splits
+}
 
-	p.Row(6, func() {
-		p.Col(1, func() {
-			p.Text(fmt.Sprintf("%v.", place), props.Text{Align: consts.Right})
-		})
-		p.ColSpace(1)
-		p.Col(4, func() {
-			p.Text(fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName))
-		})
-		p.Col(1, func() {
-			p.Text(fmt.Sprint(entry.Swimmer.Age))
-		})
-		p.Col(2, func() {
-			if entry.Entry.Result.Time == 0 {
-				p.Text("NS", props.Text{Align: consts.Right})
-			} else {
-				p.Text(fmt.Sprintf("%v", entry.Entry.Result.Time), props.Text{Align: consts.Right})
-			}
-		})
-		p.Col(2, func() {
-			p.Text(fmt.Sprintf("%v", entry.Entry.SeedTime1), props.Text{Align: consts.Right})
-		})
-	})
+func resultEntry(m *hytek.Meet, event *hytek.Event, entry *hytek.Entry, s *SheetOptions, breaks []*records.Break) *Row {
+	final, splits := recordMarks(breaks)
+	r := &Row{Cells: []string{
+		resultPlace(entry),
+		fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName),
+		fmt.Sprint(entry.Swimmer.Age),
+		TeamAbbr(entry.Team),
+		resultTime(entry),
+	}}
+	if len(s.Records()) > 0 {
+		r.Cells = append(r.Cells, strings.Join(final, " "))
+	}
+	r.Cells = append(r.Cells, fmt.Sprintf("%v", entry.Entry.SeedTime1))
+	if b := s.BaseTimes(); b != nil {
+		points := ""
+		if v := b.EntryPoints(m.CourseCode, event, entry); v > 0 {
+			points = fmt.Sprint(v)
+		}
+		r.Cells = append(r.Cells, points)
+	}
+	if len(splits) > 0 {
+		r.Note = "Split records: " + strings.Join(splits, ", ")
+	}
+	return r
 }
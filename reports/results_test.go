@@ -0,0 +1,47 @@
+package reports
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestResultsWithoutResult(t *testing.T) {
+	event := testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30, 31})
+	for _, entry := range event.Entries {
+		entry.Swimmer = &hytek.HY3SwimmerInfo1{LastName: "Swimmer"}
+	}
+	event.Entries[0].Entry.Result.Time = 29.5
+	event.Entries[0].Entry.Result.PlaceOverall = 1
+	// Entered but never swum.
+	event.Entries[1].Entry.Result = nil
+	events := []*hytek.Event{event}
+	opts := []SheetOption{RendererOption(TextRenderer)}
+	tests := []struct {
+		name   string
+		report func(*hytek.Meet, []*hytek.Event, ...SheetOption) ([]bytes.Buffer, error)
+	}{
+		{"results", ResultSheet},
+		{"hytek results", HyTekResults},
+		{"announcer", AnnouncerScript},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bufs, err := test.report(&hytek.Meet{}, events, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bufs) != 1 || !strings.Contains(bufs[0].String(), "29.50") {
+				t.Errorf("got %v sheets without the placed swim", len(bufs))
+			}
+		})
+	}
+	if got := resultTime(event.Entries[1]); got != "NS" {
+		t.Errorf("resultTime of an entry without a result = %q, want NS", got)
+	}
+	if got := resultPlace(event.Entries[1]); got != "--" {
+		t.Errorf("resultPlace of an entry without a result = %q, want --", got)
+	}
+}
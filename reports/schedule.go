@@ -0,0 +1,150 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+const (
+	scheduleDateFormat = "2006-01-02"
+	scheduleTimeFormat = "15:04"
+)
+
+// Schedule is the running order of a meet, read from a JSON file such as
+//
+//	{"sessions": [{"date": "2022-12-29", "warm_up": "09:45", "start": "10:30",
+//	  "events": ["1", "2", "3A", "3B"], "breaks": ["2"]}]}
+type Schedule struct {
+	Sessions []*ScheduleSession `json:"sessions"`
+}
+
+type ScheduleSession struct {
+	Date   string `json:"date"`
+	WarmUp string `json:"warm_up,omitempty"`
+	Start  string `json:"start"`
+	// Events are the event numbers of the session in the order they are
+	// swum.
+	Events []string `json:"events"`
+	// Breaks are the event numbers followed by a break.
+	Breaks []string `json:"breaks,omitempty"`
+}
+
+func (s *ScheduleSession) time(clock string) (time.Time, error) {
+	return time.ParseInLocation(scheduleDateFormat+" "+scheduleTimeFormat, s.Date+" "+clock, time.Local)
+}
+
+// StartTime returns the date and time the first event starts.
+func (s *ScheduleSession) StartTime() (time.Time, error) {
+	return s.time(s.Start)
+}
+
+// WarmUpTime returns the date and time warm up starts, or zero if the
+// session has none.
+func (s *ScheduleSession) WarmUpTime() (time.Time, error) {
+	if s.WarmUp == "" {
+		return time.Time{}, nil
+	}
+	return s.time(s.WarmUp)
+}
+
+// ReadSchedule reads and checks a schedule from JSON. Each break must follow
+// an event of its session.
+func ReadSchedule(r io.Reader) (*Schedule, error) {
+	s := &Schedule{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	if len(s.Sessions) == 0 {
+		return nil, fmt.Errorf("schedule has no sessions")
+	}
+	for i, session := range s.Sessions {
+		if _, err := session.StartTime(); err != nil {
+			return nil, fmt.Errorf("session %v: invalid start: %v", i+1, err)
+		}
+		if _, err := session.WarmUpTime(); err != nil {
+			return nil, fmt.Errorf("session %v: invalid warm up: %v", i+1, err)
+		}
+		events := make(map[string]bool)
+		for _, v := range session.Events {
+			events[strings.TrimSpace(v)] = true
+		}
+		for _, v := range session.Breaks {
+			if !events[strings.TrimSpace(v)] {
+				return nil, fmt.Errorf("session %v: break after event %q, which is not in the session", i+1, v)
+			}
+		}
+	}
+	return s, nil
+}
+
+// scheduledEvent returns the meet event with the number, or the combined
+// event that seeds it.
+func scheduledEvent(m *hytek.Meet, number string) *hytek.Event {
+	number = strings.TrimSpace(number)
+	for _, e := range m.Events {
+		if strings.TrimSpace(e.Number) == number {
+			return e
+		}
+		for _, c := range e.Combined {
+			if strings.TrimSpace(c.Number) == number {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// Order returns the event order of the schedule for the meet's events.
+func (s *Schedule) Order(m *hytek.Meet) ([]OrderFunc, error) {
+	var ret []OrderFunc
+	for i, session := range s.Sessions {
+		if i > 0 {
+			ret = append(ret, NewSessionOrder())
+		}
+		breaks := make(map[string]bool)
+		for _, v := range session.Breaks {
+			breaks[strings.TrimSpace(v)] = true
+		}
+		for _, number := range session.Events {
+			if scheduledEvent(m, number) == nil {
+				return nil, fmt.Errorf("session %v: unknown event %q", i+1, number)
+			}
+			ret = append(ret, EventNumberOrder(number))
+			if breaks[strings.TrimSpace(number)] {
+				ret = append(ret, BreakOrder())
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Options returns the session times and event order of the schedule.
+func (s *Schedule) Options(m *hytek.Meet) ([]SheetOption, error) {
+	order, err := s.Order(m)
+	if err != nil {
+		return nil, err
+	}
+	var starts, warmUps []time.Time
+	for _, session := range s.Sessions {
+		start, err := session.StartTime()
+		if err != nil {
+			return nil, err
+		}
+		warmUp, err := session.WarmUpTime()
+		if err != nil {
+			return nil, err
+		}
+		starts = append(starts, start)
+		warmUps = append(warmUps, warmUp)
+	}
+	return []SheetOption{
+		SessionTimesOption(starts),
+		WarmUpTimesOption(warmUps),
+		EventOrderOption(order...),
+	}, nil
+}
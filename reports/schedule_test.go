@@ -0,0 +1,40 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadScheduleBreaks(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{
+		{
+			name: "break after an event of the session",
+			json: `{"sessions": [{"date": "2022-12-29", "start": "10:30", "events": ["1", "2"], "breaks": ["1"]}]}`,
+		},
+		{
+			name:    "break after an event of another session",
+			json:    `{"sessions": [{"date": "2022-12-29", "start": "10:30", "events": ["1"]}, {"date": "2022-12-30", "start": "10:30", "events": ["2"], "breaks": ["1"]}]}`,
+			wantErr: `session 2: break after event "1"`,
+		},
+		{
+			name:    "break after an unknown event",
+			json:    `{"sessions": [{"date": "2022-12-29", "start": "10:30", "events": ["1", "2"], "breaks": ["3"]}]}`,
+			wantErr: `session 1: break after event "3"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadSchedule(strings.NewReader(test.json))
+			switch {
+			case test.wantErr == "" && err != nil:
+				t.Errorf("got error %v, want none", err)
+			case test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)):
+				t.Errorf("got error %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// SessionEstimate reports the number of heats and the estimated length of
+// each event and session.
+func SessionEstimate(m *hytek.Meet, events []*hytek.Event, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "Session estimate"})
+	for i, t := range SessionTimelines(events, opts...) {
+		sessionEstimate(p, m, t, s, i+1)
+	}
+	return p.Output()
+}
+
+func sessionEstimate(p pdf.Maroto, m *hytek.Meet, t *Timeline, s *SheetOptions, session int) {
+	heats := 0
+	for _, et := range t.Events {
+		heats += len(et.Heats)
+	}
+	p.Row(6, func() {})
+	p.Row(8, func() {
+		p.Col(6, func() {
+			p.Text(fmt.Sprintf("Session %v - %v", session, t.Start.Format("02/01/2006 - 03:04pm")), props.Text{Style: consts.Bold})
+		})
+		p.Col(6, func() {
+			p.Text(fmt.Sprintf("%v heats, %v", heats, formatDuration(t.Duration())), props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+	})
+	p.Line(1.0)
+	p.Row(6, func() {
+		p.Col(7, func() {
+			p.Text("Event", props.Text{Style: consts.Bold})
+		})
+		p.Col(1, func() {
+			p.Text("Heats", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+		p.Col(2, func() {
+			p.Text("Start", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+		p.Col(2, func() {
+			p.Text("Length", props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+	})
+	for _, et := range t.Events {
+		p.Row(6, func() {
+			p.Col(7, func() {
+				p.Text(s.EventTitle(m, et.Event))
+			})
+			p.Col(1, func() {
+				p.Text(fmt.Sprint(len(et.Heats)), props.Text{Align: consts.Right})
+			})
+			p.Col(2, func() {
+				p.Text(et.Start.Format(startTimeFormat), props.Text{Align: consts.Right})
+			})
+			p.Col(2, func() {
+				p.Text(formatDuration(et.End.Sub(et.Start)), props.Text{Align: consts.Right})
+			})
+		})
+		if et.Break != 0 {
+			p.Row(6, func() {
+				p.Col(12, func() {
+					p.Text(fmt.Sprintf("%v minute break", int(et.Break.Minutes())), props.Text{Align: consts.Center})
+				})
+			})
+		}
+	}
+	p.Line(1.0)
+	p.Row(6, func() {
+		p.Col(12, func() {
+			p.Text(fmt.Sprintf("Estimated finish: %v", t.End.Format(startTimeFormat)), props.Text{Align: consts.Right, Style: consts.Bold})
+		})
+	})
+}
+
+// BalanceSessions assigns the events to sessions in event order so that
+// each session fits within its time limit. Events in fixed, keyed by event
+// number, are kept in the given session; a combined event is kept by the
+// number of any of its age group events. Breaks from the event order are
+// kept. The returned order can be passed to EventOrderOption.
+func BalanceSessions(events []*hytek.Event, limits []time.Duration, fixed map[string]int, opts ...SheetOption) ([]OrderFunc, error) {
+	if len(limits) == 0 {
+		return nil, fmt.Errorf("no session limits")
+	}
+	s := applyOptions(opts)
+	o := s.EventOrder()
+	sorted := append([]*hytek.Event(nil), events...)
+	o.Sort(sorted)
+	t := newTimeline(sorted, time.Time{}, s)
+
+	type unit struct {
+		event    *hytek.Event
+		length   time.Duration
+		gapAfter time.Duration
+		session  int
+		brk      bool
+	}
+	var units []*unit
+	for _, event := range sorted {
+		u := &unit{event: event, session: -1}
+		units = append(units, u)
+		for _, n := range strings.Split(strings.TrimSpace(event.Number), "/") {
+			if f, ok := fixed[n]; ok {
+				u.session = f - 1
+			}
+		}
+		et := t.Event(event)
+		if et == nil {
+			continue
+		}
+		u.length = et.End.Sub(et.Start)
+		u.gapAfter = s.ChangeoverGap() + et.Break
+		u.brk = et.Break != 0
+	}
+
+	var ret []OrderFunc
+	session := 0
+	var used, gap time.Duration
+	for _, u := range units {
+		if u.session >= len(limits) {
+			return nil, fmt.Errorf("event %v is fixed to session %v but there are only %v sessions", u.event.Number, u.session+1, len(limits))
+		}
+		if u.session >= 0 && u.session < session {
+			return nil, fmt.Errorf("event %v is fixed to session %v but follows events in session %v", u.event.Number, u.session+1, session+1)
+		}
+		for session < u.session || (u.session < 0 && used > 0 && used+gap+u.length > limits[session]) {
+			session++
+			if session >= len(limits) {
+				return nil, fmt.Errorf("events don't fit in %v sessions", len(limits))
+			}
+			ret = append(ret, NewSessionOrder())
+			used, gap = 0, 0
+		}
+		if used+gap+u.length > limits[session] {
+			return nil, fmt.Errorf("event %v doesn't fit in session %v", u.event.Number, session+1)
+		}
+		if u.length > 0 {
+			used += gap + u.length
+			gap = u.gapAfter
+		}
+		ret = append(ret, EventNumberOrder(u.event.Number))
+		if u.brk {
+			ret = append(ret, BreakOrder())
+		}
+	}
+	return ret, nil
+}
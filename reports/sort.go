@@ -2,6 +2,7 @@ package reports
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/countcraicula/hytek"
 )
@@ -11,6 +12,14 @@ type eventKey struct {
 	distance int
 	relay    bool
 }
+
+// fullEventKey tells apart events of the same stroke and distance for
+// different genders and age groups.
+type fullEventKey struct {
+	eventKey
+	gender   hytek.Gender
+	ageGroup hytek.AgeGroup
+}
 type eventValue struct {
 	order      int
 	breakAfter bool
@@ -61,27 +70,26 @@ var DefaultEventOrder = []OrderFunc{
 	MixedGenderStrokeDistanceOrder(hytek.Butterfly, 200),
 }
 
-type sortEventsByStrokeAndDistance struct {
-	events []*hytek.Event
-	order  map[eventKey]*eventValue
+func orderKey(e *hytek.Event) eventKey {
+	return eventKey{
+		stroke:   e.Stroke,
+		distance: e.Distance,
+		relay:    e.Type == hytek.Relay,
+	}
 }
 
-func (a *sortEventsByStrokeAndDistance) Len() int { return len(a.events) }
-func (a *sortEventsByStrokeAndDistance) Swap(i, j int) {
-	a.events[i], a.events[j] = a.events[j], a.events[i]
-}
-func (a *sortEventsByStrokeAndDistance) Less(i, j int) bool {
-	iKey := eventKey{
-		stroke:   a.events[i].Stroke,
-		distance: a.events[i].Distance,
-		relay:    a.events[i].Type == hytek.Relay,
+// newFullEventKey builds the key with open age groups normalised, as HYV
+// files and ParseAgeGroups write them differently.
+func newFullEventKey(key eventKey, gender hytek.Gender, ageGroup hytek.AgeGroup) fullEventKey {
+	return fullEventKey{
+		eventKey: key,
+		gender:   gender,
+		ageGroup: ageGroup.Normalized(),
 	}
-	jKey := eventKey{
-		stroke:   a.events[j].Stroke,
-		distance: a.events[j].Distance,
-		relay:    a.events[j].Type == hytek.Relay,
-	}
-	return a.order[iKey].order < a.order[jKey].order
+}
+
+func orderFullKey(e *hytek.Event) fullEventKey {
+	return newFullEventKey(orderKey(e), e.Gender, e.AgeGroup())
 }
 
 type sortByHeatAndLane []*hytek.Entry
@@ -95,8 +103,30 @@ func (a sortByHeatAndLane) Less(i, j int) bool {
 	return a[i].Entry.Result.Heat < a[j].Entry.Result.Heat
 }
 
+// heatOrder returns a copy of the entries sorted by heat and lane. Entries
+// not seeded into a heat are left out.
+func heatOrder(entries hytek.Entries) hytek.Entries {
+	var ret hytek.Entries
+	for _, entry := range entries {
+		if entry.Entry != nil && entry.Entry.Result != nil {
+			ret = append(ret, entry)
+		}
+	}
+	sort.Sort(sortByHeatAndLane(ret))
+	return ret
+}
+
+// placeOrder returns a copy of the entries sorted by place.
+func placeOrder(entries hytek.Entries) hytek.Entries {
+	ret := append(hytek.Entries(nil), entries...)
+	hytek.SortByPlace(ret)
+	return ret
+}
+
 type Order struct {
 	data    map[eventKey]*eventValue
+	full    map[fullEventKey]*eventValue
+	numbers map[string]*eventValue
 	last    *eventValue
 	session int
 	event   int
@@ -107,40 +137,92 @@ func (o *Order) setEvent(k eventKey, v *eventValue) {
 	o.last = v
 }
 
+func (o *Order) setFullEvent(k fullEventKey, v *eventValue) {
+	o.full[k] = v
+	o.last = v
+}
+
+func (o *Order) setNumber(number string, v *eventValue) {
+	o.numbers[strings.TrimSpace(number)] = v
+	o.last = v
+}
+
+// lookup finds the event by its number, then by gender, age group, stroke
+// and distance, then by stroke and distance alone. A combined event is
+// found by the number of any of its age group events.
+func (o *Order) lookup(e *hytek.Event) (*eventValue, bool) {
+	number := strings.TrimSpace(e.Number)
+	if v, ok := o.numbers[number]; ok {
+		return v, true
+	}
+	for _, n := range strings.Split(number, "/") {
+		if v, ok := o.numbers[n]; ok {
+			return v, true
+		}
+	}
+	if v, ok := o.full[orderFullKey(e)]; ok {
+		return v, true
+	}
+	v, ok := o.data[orderKey(e)]
+	return v, ok
+}
+
+// Sort sorts the events in order. Events missing from the order go last,
+// in the order they were given.
 func (o *Order) Sort(e []*hytek.Event) {
-	sort.Sort(&sortEventsByStrokeAndDistance{
-		events: e,
-		order:  o.data,
+	position := func(e *hytek.Event) int {
+		if v, ok := o.lookup(e); ok {
+			return v.order
+		}
+		return o.event
+	}
+	sort.SliceStable(e, func(i, j int) bool {
+		return position(e[i]) < position(e[j])
 	})
+}
 
+// Sorted returns a copy of the events in order, leaving the events as they
+// are.
+func (o *Order) Sorted(e []*hytek.Event) []*hytek.Event {
+	ret := append([]*hytek.Event(nil), e...)
+	o.Sort(ret)
+	return ret
 }
 
-func (o *Order) BreakAfter(e *hytek.Event) bool {
-	key := eventKey{
-		stroke:   e.Stroke,
-		distance: e.Distance,
-		relay:    false,
-	}
-	v, ok := o.data[key]
-	if !ok {
+// BreakAfter reports whether there is a break after the event when it is
+// followed by next, nil for the last event. A break ordered after a stroke
+// and distance comes after the last of the events sharing it, not after
+// each of them.
+func (o *Order) BreakAfter(e, next *hytek.Event) bool {
+	v, ok := o.lookup(e)
+	if !ok || !v.breakAfter {
 		return false
 	}
-	return v.breakAfter
+	if next == nil {
+		return true
+	}
+	n, ok := o.lookup(next)
+	return !ok || n != v
 }
 
+// Sessions returns the number of sessions in the order.
+func (o *Order) Sessions() int {
+	return o.session
+}
+
+// SplitBySession splits the events with entries by session. Events missing
+// from the order go in the last session.
 func (o *Order) SplitBySession(events []*hytek.Event) [][]*hytek.Event {
 	sessions := make([][]*hytek.Event, o.session)
 	for _, event := range events {
-		k := eventKey{
-			stroke:   event.Stroke,
-			distance: event.Distance,
-			relay:    false,
-		}
-		v := o.data[k]
 		if len(event.Entries) == 0 {
 			continue
 		}
-		sessions[v.session-1] = append(sessions[v.session-1], event)
+		session := o.session
+		if v, ok := o.lookup(event); ok {
+			session = v.session
+		}
+		sessions[session-1] = append(sessions[session-1], event)
 	}
 	return sessions
 }
@@ -148,6 +230,8 @@ func (o *Order) SplitBySession(events []*hytek.Event) [][]*hytek.Event {
 func NewOrder(order ...OrderFunc) *Order {
 	o := &Order{
 		data:    make(map[eventKey]*eventValue),
+		full:    make(map[fullEventKey]*eventValue),
+		numbers: make(map[string]*eventValue),
 		session: 1,
 	}
 	for _, f := range order {
@@ -160,29 +244,64 @@ func NewOrder(order ...OrderFunc) *Order {
 
 type OrderFunc func(*Order) bool
 
-func MixedGenderStrokeDistanceOrder(stroke hytek.StrokeCode, distance int) OrderFunc {
+func keyOrder(key eventKey) OrderFunc {
 	return OrderFunc(func(o *Order) bool {
-		key := eventKey{
-			stroke:   stroke,
-			distance: distance,
-			relay:    false,
-		}
 		o.setEvent(key, &eventValue{order: o.event, session: o.session})
 		return true
 	})
 }
+
+func MixedGenderStrokeDistanceOrder(stroke hytek.StrokeCode, distance int) OrderFunc {
+	return keyOrder(eventKey{
+		stroke:   stroke,
+		distance: distance,
+		relay:    false,
+	})
+}
 func MixedGenderStrokeDistanceRelayOrder(stroke hytek.StrokeCode, distance int) OrderFunc {
+	return keyOrder(eventKey{
+		stroke:   stroke,
+		distance: distance,
+		relay:    true,
+	})
+}
+
+// EventNumberOrder orders the event with the HYV event number, e.g. "3B".
+func EventNumberOrder(number string) OrderFunc {
 	return OrderFunc(func(o *Order) bool {
-		key := eventKey{
-			stroke:   stroke,
-			distance: distance,
-			relay:    true,
-		}
-		o.setEvent(key, &eventValue{order: o.event, session: o.session})
+		o.setNumber(number, &eventValue{order: o.event, session: o.session})
+		return true
+	})
+}
+
+// EventNumbersOrder orders each of the event numbers in turn.
+func EventNumbersOrder(numbers ...string) []OrderFunc {
+	var ret []OrderFunc
+	for _, n := range numbers {
+		ret = append(ret, EventNumberOrder(n))
+	}
+	return ret
+}
+
+func fullKeyOrder(key fullEventKey) OrderFunc {
+	return OrderFunc(func(o *Order) bool {
+		o.setFullEvent(key, &eventValue{order: o.event, session: o.session})
 		return true
 	})
 }
 
+// GenderAgeStrokeDistanceOrder orders the individual event of one gender and
+// age group.
+func GenderAgeStrokeDistanceOrder(gender hytek.Gender, ageGroup hytek.AgeGroup, stroke hytek.StrokeCode, distance int) OrderFunc {
+	return fullKeyOrder(newFullEventKey(eventKey{stroke: stroke, distance: distance}, gender, ageGroup))
+}
+
+// GenderAgeStrokeDistanceRelayOrder orders the relay of one gender and age
+// group.
+func GenderAgeStrokeDistanceRelayOrder(gender hytek.Gender, ageGroup hytek.AgeGroup, stroke hytek.StrokeCode, distance int) OrderFunc {
+	return fullKeyOrder(newFullEventKey(eventKey{stroke: stroke, distance: distance, relay: true}, gender, ageGroup))
+}
+
 func BreakOrder() OrderFunc {
 	return OrderFunc(func(o *Order) bool {
 		if o.last == nil {
@@ -0,0 +1,26 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestOrderOpenAgeGroup(t *testing.T) {
+	groups, err := hytek.ParseAgeGroups("15+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := NewOrder(
+		GenderAgeStrokeDistanceOrder(hytek.Female, groups[0], hytek.Freestyle, 50),
+		NewSessionOrder(),
+		MixedGenderStrokeDistanceOrder(hytek.Backstroke, 50),
+	)
+	// HYV files write open age groups with an upper age of 99.
+	e := testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30})
+	e.Gender, e.MinAge, e.MaxAge = hytek.Female, 15, 99
+	sessions := o.SplitBySession([]*hytek.Event{e})
+	if len(sessions[0]) != 1 {
+		t.Errorf("15-99 event not ordered as 15+, sessions %v", sessions)
+	}
+}
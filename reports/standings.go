@@ -0,0 +1,64 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/scoring"
+	"github.com/johnfercher/maroto/pkg/consts"
+	"github.com/johnfercher/maroto/pkg/pdf"
+	"github.com/johnfercher/maroto/pkg/props"
+)
+
+// TeamStandings reports the team points overall, by gender and by age group.
+func TeamStandings(m *hytek.Meet, scores scoring.Scores, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	p := newPDFReport(s, []string{m.Description, m.Location, "Team standings"})
+	standingsTable(p, "Overall", scores.Overall())
+	byGender := scores.ByGender()
+	for _, g := range []hytek.Gender{hytek.Female, hytek.Male, hytek.Mixed} {
+		if v, ok := byGender[g]; ok {
+			standingsTable(p, g.Display(), v)
+		}
+	}
+	byAge := scores.ByAgeGroup()
+	for _, a := range scores.AgeGroups() {
+		standingsTable(p, fmt.Sprintf("Age %v", a), byAge[a])
+	}
+	return p.Output()
+}
+
+func standingsTable(p pdf.Maroto, title string, standings scoring.Standings) {
+	p.Row(6, func() {})
+	p.Row(8, func() {
+		p.Col(12, func() {
+			p.Text(title, props.Text{Style: consts.Bold})
+		})
+	})
+	p.Line(1.0)
+	for _, v := range standings {
+		p.Row(6, func() {
+			p.Col(1, func() {
+				p.Text(fmt.Sprintf("%v.", v.Place), props.Text{Align: consts.Right})
+			})
+			p.ColSpace(1)
+			p.Col(2, func() {
+				p.Text(v.Team.Name.Abbr)
+			})
+			p.Col(5, func() {
+				p.Text(v.Team.Name.Name)
+			})
+			p.Col(3, func() {
+				p.Text(formatPoints(v.Points), props.Text{Align: consts.Right})
+			})
+		})
+	}
+}
+
+func formatPoints(points float64) string {
+	if points == float64(int(points)) {
+		return fmt.Sprint(int(points))
+	}
+	return fmt.Sprintf("%.1f", points)
+}
@@ -0,0 +1,41 @@
+package reports
+
+import (
+	"strings"
+
+	"github.com/countcraicula/hytek"
+)
+
+// TeamAbbr is the trimmed abbreviation of the team, empty when the team has
+// no name record.
+func TeamAbbr(t *hytek.HY3SwimTeam) string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return strings.TrimSpace(t.Name.Abbr)
+}
+
+func teamFullName(t *hytek.HY3SwimTeam) string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return t.Name.Name
+}
+
+// inClub reports whether the entry is shown when the reports are filtered
+// to a club.
+func inClub(s *SheetOptions, e *hytek.Entry) bool {
+	club := s.Club()
+	return club == "" || strings.EqualFold(TeamAbbr(e.Team), club)
+}
+
+// hasClubEntry reports whether the event has an entry shown when the
+// reports are filtered to a club.
+func hasClubEntry(event *hytek.Event, s *SheetOptions) bool {
+	for _, entry := range event.Entries {
+		if inClub(s, entry) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+)
+
+// entryEvent returns the meet event with the number, looking inside
+// combined events.
+func entryEvent(m *hytek.Meet, number string) *hytek.Event {
+	number = strings.TrimSpace(number)
+	for _, e := range m.Events {
+		if strings.TrimSpace(e.Number) == number {
+			return e
+		}
+		for _, c := range e.Combined {
+			if strings.TrimSpace(c.Number) == number {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// eventPositions numbers the meet events, and the events they combine, in
+// program order.
+func eventPositions(m *hytek.Meet) map[*hytek.Event]int {
+	ret := make(map[*hytek.Event]int)
+	for _, e := range m.Events {
+		ret[e] = len(ret)
+		for _, c := range e.Combined {
+			ret[c] = len(ret)
+		}
+	}
+	return ret
+}
+
+// teamSheet renders the document of a team sheet with the renderer of the
+// options.
+func teamSheet(d *Document, s *SheetOptions) (bytes.Buffer, error) {
+	bufs, err := renderDocuments([]*Document{d}, s)
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+	return bufs[0], nil
+}
+
+var entryColumns = []*Column{
+	{Name: "Event", Space: 1, Width: 7},
+	{Name: "Seed", Width: 2, Align: AlignRight},
+	{Name: "Fee", Width: 2, Align: AlignRight},
+}
+
+var totalColumns = []*Column{
+	{Width: 8},
+	{Width: 2, Align: AlignRight, Bold: true},
+	{Width: 2, Align: AlignRight, Bold: true},
+}
+
+// EntryConfirmation lists the entries of each swimmer of the team with
+// their seed times and fees, for the club to check before the meet.
+func EntryConfirmation(m *hytek.Meet, team *hytek.HY3SwimTeam, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	d, err := entryConfirmation(m, team, s)
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+	return teamSheet(d, s)
+}
+
+func entryConfirmation(m *hytek.Meet, team *hytek.HY3SwimTeam, s *SheetOptions) (*Document, error) {
+	d := &Document{
+		Header: []string{m.Description, teamFullName(team)},
+		Title:  "Entry confirmation",
+	}
+	positions := eventPositions(m)
+
+	swimmers := append([]*hytek.HY3Swimmer(nil), team.Swimmers...)
+	sort.SliceStable(swimmers, func(i, j int) bool {
+		a, b := swimmers[i].Info1, swimmers[j].Info1
+		if a.LastName != b.LastName {
+			return a.LastName < b.LastName
+		}
+		return a.FirstName < b.FirstName
+	})
+	var total float32
+	entries := 0
+	for _, swimmer := range swimmers {
+		if len(swimmer.IndividualEntries) == 0 {
+			continue
+		}
+		type swim struct {
+			event *hytek.Event
+			entry *hytek.HY3IndividualEventEntryInfo
+		}
+		var swims []*swim
+		for _, entry := range swimmer.IndividualEntries {
+			event := entryEvent(m, entry.EventNumber)
+			if event == nil {
+				return nil, fmt.Errorf("unknown event number %q", entry.EventNumber)
+			}
+			swims = append(swims, &swim{event: event, entry: entry})
+		}
+		sort.SliceStable(swims, func(i, j int) bool { return positions[swims[i].event] < positions[swims[j].event] })
+
+		info := swimmer.Info1
+		table := &Table{Aside: strings.TrimSpace(info.ID), Columns: entryColumns}
+		var fees float32
+		for _, v := range swims {
+			fees += v.entry.EventFee
+			table.Rows = append(table.Rows, &Row{Cells: []string{
+				s.EventTitle(m, v.event),
+				v.entry.SeedTime1.String(),
+				fmt.Sprintf("%.2f", v.entry.EventFee),
+			}})
+		}
+		table.Rows = append(table.Rows, &Row{Cells: []string{"", fmt.Sprintf("%v entries", len(swims)), fmt.Sprintf("%.2f", fees)}})
+		d.Sections = append(d.Sections, &Section{
+			Title:  fmt.Sprintf("%v, %v (%v)", info.LastName, info.FirstName, info.Age),
+			Tables: []*Table{table},
+		})
+		total += fees
+		entries += len(swims)
+	}
+	d.Sections = append(d.Sections, &Section{
+		Title: "Total",
+		Tables: []*Table{{
+			Columns: totalColumns,
+			Rows:    []*Row{{Cells: []string{"", fmt.Sprintf("%v entries", entries), fmt.Sprintf("%.2f", total)}}},
+		}},
+	})
+	return d, nil
+}
+
+var coachColumns = []*Column{
+	{Name: "Time", Width: 1},
+	{Name: "Event", Width: 5},
+	{Name: "H/L", Width: 1},
+	{Name: "Name", Width: 3},
+	{Name: "Seed", Width: 2, Align: AlignRight},
+}
+
+// CoachSheet lists the heats and lanes of the team's swimmers in the order
+// they swim, with the estimated start of each heat. The events must be
+// seeded.
+func CoachSheet(m *hytek.Meet, team *hytek.HY3SwimTeam, events []*hytek.Event, opts ...SheetOption) (bytes.Buffer, error) {
+	s := applyOptions(opts)
+	return teamSheet(coachSheet(m, team, events, s), s)
+}
+
+func coachSheet(m *hytek.Meet, team *hytek.HY3SwimTeam, events []*hytek.Event, s *SheetOptions) *Document {
+	d := &Document{
+		Header: []string{m.Description, teamFullName(team)},
+		Title:  "Coach sheet",
+	}
+	var table *Table
+	session := 0
+	for _, swim := range teamSwims(team, events, s) {
+		if swim.Session != session || table == nil {
+			session = swim.Session
+			table = &Table{Columns: coachColumns}
+			d.Sections = append(d.Sections, &Section{
+				Title:  fmt.Sprintf("Session %v - %v", session, s.SessionTime(session).Format("02/01/2006")),
+				Tables: []*Table{table},
+			})
+		}
+		entry := swim.Entry
+		table.Rows = append(table.Rows, &Row{Cells: []string{
+			swim.Start.Format(startTimeFormat),
+			s.EventTitle(m, swim.Event),
+			fmt.Sprintf("%v/%v", entry.Entry.Result.Heat, entry.Entry.Result.Lane),
+			fmt.Sprintf("%v, %v", entry.Swimmer.LastName, entry.Swimmer.FirstName),
+			entry.Entry.SeedTime1.String(),
+		}})
+	}
+	return d
+}
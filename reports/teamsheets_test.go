@@ -0,0 +1,42 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestTeamSheetsText(t *testing.T) {
+	event := testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30})
+	team := &hytek.HY3SwimTeam{
+		Name: &hytek.HY3SwimTeamNameInfo{Abbr: "ABC", Name: "A Swim Club"},
+		Swimmers: []*hytek.HY3Swimmer{{
+			Info1:             &hytek.HY3SwimmerInfo1{ID: "S1", LastName: "Swimmer", FirstName: "Sam", Age: 10},
+			IndividualEntries: []*hytek.HY3IndividualEventEntryInfo{{EventNumber: "1", SeedTime1: 30, EventFee: 5}},
+		}},
+	}
+	entry := event.Entries[0]
+	entry.Swimmer, entry.Team = team.Swimmers[0].Info1, team
+	m := &hytek.Meet{Description: "Meet", Events: []*hytek.Event{event}}
+	opts := []SheetOption{RendererOption(TextRenderer)}
+
+	confirmation, err := EntryConfirmation(m, team, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Entry confirmation", "Swimmer, Sam (10)", "30.00", "5.00", "1 entries"} {
+		if !strings.Contains(confirmation.String(), want) {
+			t.Errorf("entry confirmation is missing %q:\n%v", want, confirmation.String())
+		}
+	}
+	coach, err := CoachSheet(m, team, []*hytek.Event{event}, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Coach sheet", "Session 1", "1/1", "Swimmer, Sam"} {
+		if !strings.Contains(coach.String(), want) {
+			t.Errorf("coach sheet is missing %q:\n%v", want, coach.String())
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package reports
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/johnfercher/maroto/pkg/consts"
+)
+
+type textRenderer struct{}
+
+func (textRenderer) Extension() string { return "txt" }
+
+// textWidth is the line length of the page in characters.
+func textWidth(s *SheetOptions) int {
+	if s.Orientation() == consts.Landscape {
+		return 120
+	}
+	return 80
+}
+
+// textLine lays out text in a line of width characters. Each value is
+// placed in the span [start, end) of the line, aligned left, right or
+// centred, and cut to leave a space before the next span.
+type textLine []rune
+
+func newTextLine(width int) textLine {
+	return textLine([]rune(strings.Repeat(" ", width)))
+}
+
+func (l textLine) put(start, end int, v string, align consts.Align) {
+	if start >= len(l) {
+		return
+	}
+	if end > len(l)+1 {
+		end = len(l) + 1
+	}
+	r := []rune(v)
+	if n := end - start - 1; len(r) > n {
+		if n < 0 {
+			n = 0
+		}
+		r = r[:n]
+	}
+	at := start
+	switch align {
+	case consts.Right:
+		at = end - 1 - len(r)
+	case consts.Center:
+		at = start + (end-start-len(r))/2
+	}
+	if at < start {
+		at = start
+	}
+	copy(l[at:], r)
+}
+
+func (l textLine) String() string {
+	return strings.TrimRight(string(l), " ")
+}
+
+func (textRenderer) Render(d *Document, s *SheetOptions) (bytes.Buffer, error) {
+	var buf bytes.Buffer
+	width := textWidth(s)
+	writeln := func(v string) {
+		buf.WriteString(v)
+		buf.WriteString("\n")
+	}
+	rule := func(c string) {
+		writeln(strings.Repeat(c, width))
+	}
+	pageHeader := func(heading string) {
+		if n := len(d.Header); n > 0 {
+			l := newTextLine(width)
+			for i, v := range d.Header {
+				align := consts.Center
+				switch i {
+				case 0:
+					align = consts.Left
+				case n - 1:
+					align = consts.Right
+				}
+				end := (i + 1) * width / n
+				if i == n-1 {
+					end = width + 1
+				}
+				l.put(i*width/n, end, v, align)
+			}
+			writeln(l.String())
+			rule("=")
+		}
+		title := d.Title
+		if heading != "" {
+			title += " - " + heading
+		}
+		l := newTextLine(width)
+		l.put(0, width, title, consts.Center)
+		writeln(l.String())
+		rule("=")
+	}
+
+	heading := ""
+	if len(d.Sections) > 0 {
+		heading = d.Sections[0].Heading
+	}
+	pageHeader(heading)
+	for i, section := range d.Sections {
+		// Form feeds start a new page when printed.
+		if section.Heading != "" && i > 0 {
+			buf.WriteString("\f")
+			pageHeader(section.Heading)
+		}
+		writeln("")
+		if section.Title != "" {
+			writeln(section.Title)
+		}
+		for _, v := range section.Notes {
+			writeln("  " + v)
+		}
+		rule("-")
+		for _, t := range section.Tables {
+			textTable(writeln, width, t)
+		}
+		if section.Footer != "" {
+			rule("-")
+			l := newTextLine(width)
+			l.put(0, width, section.Footer, consts.Center)
+			writeln(l.String())
+			rule("-")
+		}
+	}
+	return buf, nil
+}
+
+func textTable(writeln func(string), width int, t *Table) {
+	if t.Title != "" || t.Aside != "" {
+		l := newTextLine(width)
+		l.put(0, width/2, t.Title, consts.Left)
+		l.put(width/2, width+1, t.Aside, consts.Right)
+		writeln(l.String())
+	}
+	if t.HasColumnNames() {
+		names := make([]string, len(t.Columns))
+		for i, c := range t.Columns {
+			names[i] = c.Name
+		}
+		writeln(textRow(width, t.Columns, names))
+	}
+	for _, r := range t.Rows {
+		writeln(textRow(width, t.Columns, r.Cells))
+		if r.Note != "" {
+			writeln(strings.Repeat(" ", width/6) + r.Note)
+		}
+		if t.WriteIn {
+			writeln("")
+		}
+	}
+}
+
+func textRow(width int, columns []*Column, cells []string) string {
+	l := newTextLine(width)
+	var pos uint
+	for i, c := range columns {
+		pos += c.Space
+		if i < len(cells) {
+			align := consts.Left
+			if c.Align == AlignRight {
+				align = consts.Right
+			}
+			l.put(int(pos)*width/12, int(pos+c.Width)*width/12, cells[i], align)
+		}
+		pos += c.Width
+	}
+	return l.String()
+}
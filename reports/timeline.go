@@ -0,0 +1,173 @@
+package reports
+
+import (
+	"sort"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+// HeatTiming is the estimated start and length of a single heat.
+type HeatTiming struct {
+	Heat     int
+	Start    time.Time
+	Duration time.Duration
+}
+
+// EventTiming is the estimated start and end of an event and its heats.
+type EventTiming struct {
+	Event *hytek.Event
+	Start time.Time
+	End   time.Time
+	Heats []*HeatTiming
+	// Break is the length of the break scheduled after the event.
+	Break time.Duration
+}
+
+// Timeline estimates when each event and heat of a session will start. A
+// heat lasts as long as its slowest seed time plus the start gap, and the
+// changeover gap and any breaks from the event order are added between
+// events.
+type Timeline struct {
+	Start   time.Time
+	End     time.Time
+	Events  []*EventTiming
+	byEvent map[*hytek.Event]*EventTiming
+}
+
+// NewTimeline estimates the timeline of the events, which must have had
+// their heats assigned, in event order starting at start.
+func NewTimeline(events []*hytek.Event, start time.Time, opts ...SheetOption) *Timeline {
+	return newTimeline(events, start, applyOptions(opts))
+}
+
+// SessionTimelines estimates a timeline for each session of the events,
+// starting at the session times.
+func SessionTimelines(events []*hytek.Event, opts ...SheetOption) []*Timeline {
+	s := applyOptions(opts)
+	eventList := [][]*hytek.Event{events}
+	if s.BySession() {
+		eventList = s.EventOrder().SplitBySession(events)
+	}
+	var ret []*Timeline
+	for i, events := range eventList {
+		ret = append(ret, newTimeline(events, s.SessionTime(i+1), s))
+	}
+	return ret
+}
+
+func newTimeline(events []*hytek.Event, start time.Time, s *SheetOptions) *Timeline {
+	t := &Timeline{
+		Start:   start,
+		byEvent: make(map[*hytek.Event]*EventTiming),
+	}
+	o := s.EventOrder()
+	sorted := append([]*hytek.Event(nil), events...)
+	o.Sort(sorted)
+	var swum []*hytek.Event
+	for _, event := range sorted {
+		if len(event.Entries) > 0 {
+			swum = append(swum, event)
+		}
+	}
+	t.End = start
+	curr := start
+	for i, event := range swum {
+		var next *hytek.Event
+		if i+1 < len(swum) {
+			next = swum[i+1]
+		}
+		et := &EventTiming{
+			Event: event,
+			Start: curr,
+		}
+		for _, heat := range heatDurations(event, s) {
+			heat.Start = curr
+			curr = curr.Add(heat.Duration)
+			et.Heats = append(et.Heats, heat)
+		}
+		et.End = curr
+		t.End = curr
+		curr = curr.Add(s.ChangeoverGap())
+		if o.BreakAfter(event, next) {
+			et.Break = s.BreakDuration()
+			curr = curr.Add(et.Break)
+		}
+		t.Events = append(t.Events, et)
+		t.byEvent[event] = et
+	}
+	return t
+}
+
+func heatDurations(event *hytek.Event, s *SheetOptions) []*HeatTiming {
+	slowest := make(map[int]time.Duration)
+	for _, entry := range event.Entries {
+		if entry.Entry == nil || entry.Entry.Result == nil {
+			continue
+		}
+		d := swimDuration(event, entry.Entry.SeedTime1, s)
+		if d > slowest[entry.Entry.Result.Heat] {
+			slowest[entry.Entry.Result.Heat] = d
+		}
+	}
+	var ret []*HeatTiming
+	for heat, d := range slowest {
+		ret = append(ret, &HeatTiming{
+			Heat:     heat,
+			Duration: d + s.StartGap(),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Heat < ret[j].Heat })
+	return ret
+}
+
+// swimDuration estimates the length of a swim from the seed time, falling
+// back on the no time pace for swimmers without one.
+func swimDuration(event *hytek.Event, seed hytek.HY3Time, s *SheetOptions) time.Duration {
+	if seed == 0 {
+		return time.Duration(event.Distance/25) * s.NoTimePace()
+	}
+	return time.Duration(float64(seed) * float64(time.Second))
+}
+
+// Event returns the timing of the event, or nil if the event is not in the
+// timeline.
+func (t *Timeline) Event(e *hytek.Event) *EventTiming {
+	return t.byEvent[e]
+}
+
+// EventStart returns the estimated start of the event.
+func (t *Timeline) EventStart(e *hytek.Event) time.Time {
+	if et := t.Event(e); et != nil {
+		return et.Start
+	}
+	return t.Start
+}
+
+// HeatStart returns the estimated start of a heat of the event.
+func (t *Timeline) HeatStart(e *hytek.Event, heat int) time.Time {
+	if h := t.Heat(e, heat); h != nil {
+		return h.Start
+	}
+	return t.EventStart(e)
+}
+
+// Heat returns the timing of a heat of the event, or nil if there is no
+// such heat.
+func (t *Timeline) Heat(e *hytek.Event, heat int) *HeatTiming {
+	et := t.Event(e)
+	if et == nil {
+		return nil
+	}
+	for _, h := range et.Heats {
+		if h.Heat == heat {
+			return h
+		}
+	}
+	return nil
+}
+
+// Duration returns the estimated length of the timeline.
+func (t *Timeline) Duration() time.Duration {
+	return t.End.Sub(t.Start)
+}
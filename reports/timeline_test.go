@@ -0,0 +1,149 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/countcraicula/hytek"
+)
+
+func testEvent(number string, stroke hytek.StrokeCode, distance int, heats ...[]hytek.HY3Time) *hytek.Event {
+	e := &hytek.Event{Number: number, Stroke: stroke, Distance: distance, Type: hytek.Individual}
+	for h, seeds := range heats {
+		for l, seed := range seeds {
+			e.Entries = append(e.Entries, &hytek.Entry{Entry: &hytek.HY3IndividualEventEntryInfo{
+				SeedTime1: seed,
+				Result:    &hytek.HY3IndividualEventResults{Heat: h + 1, Lane: l + 1},
+			}})
+		}
+	}
+	return e
+}
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	opts := []SheetOption{
+		StartGapOption(30 * time.Second),
+		ChangeoverGapOption(time.Minute),
+		BreakDurationOption(10 * time.Minute),
+		NoTimePaceOption(30 * time.Second),
+	}
+	type heat struct {
+		start    time.Duration
+		duration time.Duration
+	}
+	type event struct {
+		start, end, brk time.Duration
+		heats           []heat
+	}
+	tests := []struct {
+		name   string
+		order  []OrderFunc
+		events []*hytek.Event
+		want   []event
+		end    time.Duration
+	}{
+		{
+			name: "slowest seed sets the heat",
+			events: []*hytek.Event{
+				testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30, 40.5}, []hytek.HY3Time{35}),
+			},
+			want: []event{{
+				start: 0,
+				end:   135*time.Second + 500*time.Millisecond,
+				heats: []heat{
+					{0, 70*time.Second + 500*time.Millisecond},
+					{70*time.Second + 500*time.Millisecond, 65 * time.Second},
+				},
+			}},
+			end: 135*time.Second + 500*time.Millisecond,
+		},
+		{
+			name: "no time uses the pace",
+			events: []*hytek.Event{
+				testEvent("1", hytek.Freestyle, 100, []hytek.HY3Time{0, 60}),
+			},
+			want: []event{{
+				start: 0,
+				end:   150 * time.Second,
+				heats: []heat{{0, 150 * time.Second}},
+			}},
+			end: 150 * time.Second,
+		},
+		{
+			name: "changeover and break between events",
+			order: []OrderFunc{
+				MixedGenderStrokeDistanceOrder(hytek.Freestyle, 50),
+				BreakOrder(),
+				MixedGenderStrokeDistanceOrder(hytek.Backstroke, 50),
+			},
+			events: []*hytek.Event{
+				testEvent("2", hytek.Backstroke, 50, []hytek.HY3Time{60}),
+				testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30}),
+				testEvent("3", hytek.Butterfly, 50),
+			},
+			want: []event{
+				{start: 0, end: 60 * time.Second, brk: 10 * time.Minute, heats: []heat{{0, 60 * time.Second}}},
+				{start: 12 * time.Minute, end: 13*time.Minute + 30*time.Second, heats: []heat{{12 * time.Minute, 90 * time.Second}}},
+			},
+			end: 13*time.Minute + 30*time.Second,
+		},
+		{
+			name: "break after the last event of a stroke and distance",
+			order: []OrderFunc{
+				MixedGenderStrokeDistanceOrder(hytek.Freestyle, 50),
+				BreakOrder(),
+				MixedGenderStrokeDistanceOrder(hytek.Backstroke, 50),
+			},
+			events: []*hytek.Event{
+				testEvent("1", hytek.Freestyle, 50, []hytek.HY3Time{30}),
+				testEvent("2", hytek.Freestyle, 50, []hytek.HY3Time{30}),
+				testEvent("3", hytek.Backstroke, 50, []hytek.HY3Time{30}),
+			},
+			want: []event{
+				{start: 0, end: time.Minute, heats: []heat{{0, time.Minute}}},
+				{start: 2 * time.Minute, end: 3 * time.Minute, brk: 10 * time.Minute, heats: []heat{{2 * time.Minute, time.Minute}}},
+				{start: 14 * time.Minute, end: 15 * time.Minute, heats: []heat{{14 * time.Minute, time.Minute}}},
+			},
+			end: 15 * time.Minute,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := append([]SheetOption{EventOrderOption(test.order...)}, opts...)
+			tl := NewTimeline(test.events, start, o...)
+			if got := tl.End.Sub(start); got != test.end {
+				t.Errorf("End = %v, want %v", got, test.end)
+			}
+			if len(tl.Events) != len(test.want) {
+				t.Fatalf("got %v events, want %v", len(tl.Events), len(test.want))
+			}
+			for i, want := range test.want {
+				et := tl.Events[i]
+				if got := et.Start.Sub(start); got != want.start {
+					t.Errorf("event %v: Start = %v, want %v", et.Event.Number, got, want.start)
+				}
+				if got := et.End.Sub(start); got != want.end {
+					t.Errorf("event %v: End = %v, want %v", et.Event.Number, got, want.end)
+				}
+				if et.Break != want.brk {
+					t.Errorf("event %v: Break = %v, want %v", et.Event.Number, et.Break, want.brk)
+				}
+				if len(et.Heats) != len(want.heats) {
+					t.Fatalf("event %v: got %v heats, want %v", et.Event.Number, len(et.Heats), len(want.heats))
+				}
+				for j, h := range want.heats {
+					if got := et.Heats[j].Start.Sub(start); got != h.start {
+						t.Errorf("event %v heat %v: Start = %v, want %v", et.Event.Number, j+1, got, h.start)
+					}
+					if et.Heats[j].Duration != h.duration {
+						t.Errorf("event %v heat %v: Duration = %v, want %v", et.Event.Number, j+1, et.Heats[j].Duration, h.duration)
+					}
+					if tl.HeatStart(et.Event, j+1) != et.Heats[j].Start {
+						t.Errorf("event %v: HeatStart(%v) disagrees with the heat", et.Event.Number, j+1)
+					}
+				}
+			}
+		})
+	}
+}
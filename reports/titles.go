@@ -0,0 +1,94 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/countcraicula/hytek"
+)
+
+// DefaultEventTitle renders titles such as
+// "Event 3B Girls 11-12 100 SC Meter Backstroke".
+const DefaultEventTitle = "Event {{.Number}} {{.Gender}} {{.AgeGroup}} {{.Distance}} {{.Course}} {{.Stroke}}"
+
+var defaultEventTitle = template.Must(ParseEventTitle(DefaultEventTitle))
+
+var courseTitles = map[hytek.CourseCode]string{
+	hytek.ShortMetres: "SC Meter",
+	hytek.ShortYards:  "SC Yard",
+	hytek.LongMeters:  "LC Meter",
+}
+
+// resultCourses are the courses of the length units results record.
+var resultCourses = map[string]hytek.CourseCode{
+	"S": hytek.ShortMetres,
+	"Y": hytek.ShortYards,
+	"L": hytek.LongMeters,
+}
+
+// EventTitle is the data an event title template is executed with. Fields
+// that don't apply to the event are empty.
+type EventTitle struct {
+	Number string
+	// Gender is Girls, Boys or Mixed.
+	Gender string
+	// AgeGroup is empty for open events.
+	AgeGroup string
+	// Distance is the relay legs for relays, e.g. 4x25.
+	Distance string
+	Course   string
+	// Stroke ends in Relay for relays.
+	Stroke string
+}
+
+func newEventTitle(course hytek.CourseCode, e *hytek.Event) *EventTitle {
+	t := &EventTitle{
+		Number:   e.Number,
+		Gender:   e.Gender.Display(),
+		Distance: fmt.Sprint(e.Distance),
+		Course:   courseTitles[course],
+		Stroke:   e.Stroke.Display(),
+	}
+	if a := e.AgeGroup(); a.MinAge > 0 || (a.MaxAge > 0 && a.MaxAge < 99) {
+		t.AgeGroup = a.String()
+	}
+	if e.Type == hytek.Relay {
+		t.Distance = fmt.Sprintf("4x%v", e.Distance/4)
+		t.Stroke += " Relay"
+	}
+	return t
+}
+
+// ParseEventTitle parses a template for event titles, executed with an
+// EventTitle. The template is tried on a sample event so that unknown
+// fields are reported here rather than when the reports are written.
+func ParseEventTitle(text string) (*template.Template, error) {
+	t, err := template.New("event").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	sample := &hytek.Event{Number: "1", Gender: hytek.Female, MinAge: 11, MaxAge: 12, Distance: 100, Stroke: hytek.Backstroke}
+	if err := t.Execute(io.Discard, newEventTitle(hytek.ShortMetres, sample)); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// FormatEventTitle renders the title of the event with the template, or
+// DefaultEventTitle if it's nil. Runs of spaces left by empty fields are
+// collapsed. Templates from ParseEventTitle have already been tried, so the
+// title falls back on the event number only for templates made elsewhere
+// that fail.
+func FormatEventTitle(t *template.Template, course hytek.CourseCode, e *hytek.Event) string {
+	if t == nil {
+		t = defaultEventTitle
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, newEventTitle(course, e)); err != nil {
+		return fmt.Sprintf("Event %v", e.Number)
+	}
+	return strings.Join(strings.Fields(buf.String()), " ")
+}
@@ -0,0 +1,34 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestParseEventTitle(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{text: DefaultEventTitle, want: "Event 3B Girls 11-12 100 SC Meter Backstroke"},
+		{text: "{{.Distance}}m {{.Stroke}} ({{.Gender}})", want: "100m Backstroke (Girls)"},
+		{text: "{{.Number", wantErr: true},
+		{text: "{{.Event}}", wantErr: true},
+	}
+	e := &hytek.Event{Number: "3B", Gender: hytek.Female, MinAge: 11, MaxAge: 12, Distance: 100, Stroke: hytek.Backstroke}
+	for _, test := range tests {
+		tmpl, err := ParseEventTitle(test.text)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseEventTitle(%q) error = %v, want error %v", test.text, err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := FormatEventTitle(tmpl, hytek.ShortMetres, e); got != test.want {
+			t.Errorf("FormatEventTitle(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package scoring
+
+import (
+	"sort"
+
+	"github.com/countcraicula/hytek"
+)
+
+// SwimmerPoints is a swimmer's total points from individual events.
+type SwimmerPoints struct {
+	Place   int
+	Swimmer *hytek.HY3SwimmerInfo1
+	Team    *hytek.HY3SwimTeam
+	Points  float64
+	// Scores are the swims that scored points.
+	Scores Scores
+}
+
+func (s *SwimmerPoints) placeCount(place int) int {
+	n := 0
+	for _, score := range s.Scores {
+		if score.Place == place {
+			n++
+		}
+	}
+	return n
+}
+
+// HighPointTieBreak compares swimmers on the same points. It returns a
+// negative number when a ranks ahead of b, a positive number when b ranks
+// ahead of a and zero when it can't separate them.
+type HighPointTieBreak func(a, b *SwimmerPoints) int
+
+// MostWins ranks the swimmer with more first places ahead.
+func MostWins(a, b *SwimmerPoints) int {
+	return b.placeCount(1) - a.placeCount(1)
+}
+
+// BestPlaces ranks the swimmer with more first places ahead, then more
+// second places and so on.
+func BestPlaces(a, b *SwimmerPoints) int {
+	last := 0
+	for _, s := range append(append(Scores(nil), a.Scores...), b.Scores...) {
+		if s.Place > last {
+			last = s.Place
+		}
+	}
+	for place := 1; place <= last; place++ {
+		if c := b.placeCount(place) - a.placeCount(place); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// FewestEvents ranks the swimmer who scored in fewer events ahead.
+func FewestEvents(a, b *SwimmerPoints) int {
+	return len(a.Scores) - len(b.Scores)
+}
+
+// HighPointAward ranks the swimmers of one age group and gender by points.
+type HighPointAward struct {
+	AgeGroup hytek.AgeGroup
+	Gender   hytek.Gender
+	Swimmers []*SwimmerPoints
+}
+
+func (a *HighPointAward) Winners() []*SwimmerPoints {
+	return a.placed(1)
+}
+
+// RunnersUp returns the swimmers placed next after the winners.
+func (a *HighPointAward) RunnersUp() []*SwimmerPoints {
+	w := a.Winners()
+	if len(w) == len(a.Swimmers) {
+		return nil
+	}
+	return a.placed(a.Swimmers[len(w)].Place)
+}
+
+func (a *HighPointAward) placed(place int) []*SwimmerPoints {
+	var ret []*SwimmerPoints
+	for _, v := range a.Swimmers {
+		if v.Place == place {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// HighPoint totals the individual points of each swimmer in the eligible
+// events and ranks them within their age group and gender. Only swims that
+// scored count, so FewestEvents ignores places past the end of the table.
+// Swimmers are grouped by age into the given age groups, or the age groups
+// of the events scored if there are none. Swimmers on the same points are
+// separated by the tie breaks in turn and share a place if still tied.
+func (s Scores) HighPoint(groups []hytek.AgeGroup, eligible func(*hytek.Event) bool, tieBreaks ...HighPointTieBreak) []*HighPointAward {
+	if len(groups) == 0 {
+		groups = s.AgeGroups()
+	}
+	type key struct {
+		group  hytek.AgeGroup
+		gender hytek.Gender
+	}
+	awards := make(map[key]*HighPointAward)
+	swimmers := make(map[*hytek.HY3SwimmerInfo1]*SwimmerPoints)
+	var ret []*HighPointAward
+	for _, score := range s {
+		if score.Event.Type == hytek.Relay || score.Entry.Swimmer == nil || score.Points == 0 {
+			continue
+		}
+		if eligible != nil && !eligible(score.Event) {
+			continue
+		}
+		swimmer := score.Entry.Swimmer
+		v, ok := swimmers[swimmer]
+		if !ok {
+			group, ok := ageGroupOf(groups, swimmer.Age)
+			if !ok {
+				continue
+			}
+			k := key{group: group, gender: swimmer.Gender}
+			award, ok := awards[k]
+			if !ok {
+				award = &HighPointAward{AgeGroup: group, Gender: swimmer.Gender}
+				awards[k] = award
+				ret = append(ret, award)
+			}
+			v = &SwimmerPoints{Swimmer: swimmer, Team: score.Entry.Team}
+			swimmers[swimmer] = v
+			award.Swimmers = append(award.Swimmers, v)
+		}
+		v.Points += score.Points
+		v.Scores = append(v.Scores, score)
+	}
+	for _, award := range ret {
+		rankSwimmers(award.Swimmers, tieBreaks)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].AgeGroup != ret[j].AgeGroup {
+			return ret[i].AgeGroup.MinAge < ret[j].AgeGroup.MinAge
+		}
+		return ret[i].Gender < ret[j].Gender
+	})
+	return ret
+}
+
+func ageGroupOf(groups []hytek.AgeGroup, age int) (hytek.AgeGroup, bool) {
+	for _, g := range groups {
+		if g.Contains(age) {
+			return g, true
+		}
+	}
+	return hytek.AgeGroup{}, false
+}
+
+func rankSwimmers(s []*SwimmerPoints, tieBreaks []HighPointTieBreak) {
+	compare := func(a, b *SwimmerPoints) int {
+		switch {
+		case a.Points > b.Points:
+			return -1
+		case a.Points < b.Points:
+			return 1
+		}
+		for _, t := range tieBreaks {
+			if c := t(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+	sort.SliceStable(s, func(i, j int) bool {
+		if c := compare(s[i], s[j]); c != 0 {
+			return c < 0
+		}
+		return s[i].Swimmer.LastName < s[j].Swimmer.LastName
+	})
+	for i, v := range s {
+		v.Place = i + 1
+		if i > 0 && compare(s[i-1], v) == 0 {
+			v.Place = s[i-1].Place
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+func TestHighPointCountsScoringSwims(t *testing.T) {
+	a := &hytek.HY3SwimmerInfo1{LastName: "A", Age: 10}
+	b := &hytek.HY3SwimmerInfo1{LastName: "B", Age: 10}
+	entry := func(s *hytek.HY3SwimmerInfo1) *hytek.Entry {
+		return &hytek.Entry{Swimmer: s, Team: &hytek.HY3SwimTeam{}}
+	}
+	event := &hytek.Event{Type: hytek.Individual, MinAge: 9, MaxAge: 10}
+	scores := Scores{
+		{Event: event, Entry: entry(a), Place: 1, Points: 9},
+		{Event: event, Entry: entry(b), Place: 2, Points: 7},
+		{Event: event, Entry: entry(b), Place: 3, Points: 2},
+		// Placed past the end of the table.
+		{Event: event, Entry: entry(a), Place: 9, Points: 0},
+	}
+	awards := scores.HighPoint([]hytek.AgeGroup{{MinAge: 9, MaxAge: 10}}, nil, FewestEvents)
+	if len(awards) != 1 {
+		t.Fatalf("got %v awards, want 1", len(awards))
+	}
+	got := awards[0].Swimmers
+	if len(got) != 2 || got[0].Swimmer != a || got[1].Swimmer != b {
+		t.Fatalf("got swimmers %v, want A then B", got)
+	}
+	if len(got[0].Scores) != 1 || got[0].Place != 1 || got[1].Place != 2 {
+		t.Errorf("A has %v scores and place %v, B place %v; want 1 score, places 1 and 2", len(got[0].Scores), got[0].Place, got[1].Place)
+	}
+}
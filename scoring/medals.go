@@ -0,0 +1,78 @@
+package scoring
+
+import (
+	"sort"
+
+	"github.com/countcraicula/hytek"
+)
+
+// Medals is a team's count of first, second and third places.
+type Medals struct {
+	Place  int
+	Team   *hytek.HY3SwimTeam
+	Gold   int
+	Silver int
+	Bronze int
+}
+
+func (m *Medals) Total() int {
+	return m.Gold + m.Silver + m.Bronze
+}
+
+func (m *Medals) compare(o *Medals) int {
+	switch {
+	case m.Gold != o.Gold:
+		return o.Gold - m.Gold
+	case m.Silver != o.Silver:
+		return o.Silver - m.Silver
+	}
+	return o.Bronze - m.Bronze
+}
+
+// MedalTable counts the medals won by each team in the placed events, each
+// age group of a split event awarding its own. Teams are ranked by golds,
+// then silvers, then bronzes and share a place if level on all three.
+func MedalTable(events []*hytek.Event) []*Medals {
+	byTeam := make(map[*hytek.HY3SwimTeam]*Medals)
+	var ret []*Medals
+	for _, e := range events {
+		for _, event := range e.ResultGroups() {
+			for _, entry := range event.Entries {
+				if entry.Team == nil || entry.Entry == nil || entry.Entry.Result == nil {
+					continue
+				}
+				place := entry.Entry.Result.PlaceOverall
+				if place < 1 || place > 3 {
+					continue
+				}
+				v, ok := byTeam[entry.Team]
+				if !ok {
+					v = &Medals{Team: entry.Team}
+					byTeam[entry.Team] = v
+					ret = append(ret, v)
+				}
+				switch place {
+				case 1:
+					v.Gold++
+				case 2:
+					v.Silver++
+				case 3:
+					v.Bronze++
+				}
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if c := ret[i].compare(ret[j]); c != 0 {
+			return c < 0
+		}
+		return teamName(ret[i].Team) < teamName(ret[j].Team)
+	})
+	for i, v := range ret {
+		v.Place = i + 1
+		if i > 0 && v.compare(ret[i-1]) == 0 {
+			v.Place = ret[i-1].Place
+		}
+	}
+	return ret
+}
@@ -0,0 +1,223 @@
+package scoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/countcraicula/hytek"
+)
+
+// Table awards points by place.
+type Table struct {
+	Individual []float64
+	Relay      []float64
+	// MaxScorers is the most swimmers or relay teams from one team that
+	// score in an event. Zero means no limit.
+	MaxScorers int
+}
+
+var (
+	NineSevenSix = &Table{
+		Individual: []float64{9, 7, 6, 5, 4, 3, 2, 1},
+		Relay:      []float64{18, 14, 12, 10, 8, 6, 4, 2},
+	}
+	TwentySeventeenSixteen = &Table{
+		Individual: []float64{20, 17, 16, 15, 14, 13, 12, 11, 9, 7, 6, 5, 4, 3, 2, 1},
+		Relay:      []float64{40, 34, 32, 30, 28, 26, 24, 22, 18, 14, 12, 10, 8, 6, 4, 2},
+	}
+)
+
+// ParsePoints parses a comma separated list of points such as
+// "9,7,6,5,4,3,2,1".
+func ParsePoints(s string) ([]float64, error) {
+	var ret []float64
+	for _, v := range strings.Split(s, ",") {
+		var points float64
+		if _, err := fmt.Sscan(strings.TrimSpace(v), &points); err != nil {
+			return nil, fmt.Errorf("failed to parse points %q: %v", v, err)
+		}
+		ret = append(ret, points)
+	}
+	return ret, nil
+}
+
+func (t *Table) points(e *hytek.Event) []float64 {
+	if e.Type == hytek.Relay {
+		return t.Relay
+	}
+	return t.Individual
+}
+
+// Score is the points scored by a single swim.
+type Score struct {
+	// Event is the event, or age group of a combined or split event, the
+	// swim was placed in.
+	Event *hytek.Event
+	Entry *hytek.Entry
+	// Place is the scoring place, which skips swimmers over the team
+	// limit.
+	Place  int
+	Points float64
+}
+
+func (s *Score) Gender() hytek.Gender {
+	if s.Entry.Swimmer != nil {
+		return s.Entry.Swimmer.Gender
+	}
+	return s.Event.Gender
+}
+
+type Scores []*Score
+
+// Score awards points for the placed results of the events and their
+// relays. Swimmers tied on a place share the points for the places they
+// cover.
+func (t *Table) Score(events []*hytek.Event) Scores {
+	var ret Scores
+	for _, event := range events {
+		for _, g := range event.ResultGroups() {
+			ret = append(ret, t.scoreEvent(g, g.Entries)...)
+		}
+		for _, r := range event.RelayEvents() {
+			ret = append(ret, t.scoreEvent(r, r.Relays)...)
+		}
+	}
+	return ret
+}
+
+func (t *Table) scoreEvent(e *hytek.Event, entries hytek.Entries) Scores {
+	var placed []*hytek.Entry
+	for _, entry := range entries {
+		if r := entry.Result(); r == nil || r.PlaceOverall == 0 {
+			continue
+		}
+		placed = append(placed, entry)
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		return placed[i].Result().PlaceOverall < placed[j].Result().PlaceOverall
+	})
+	teamCount := make(map[*hytek.HY3SwimTeam]int)
+	var scorers []*hytek.Entry
+	for _, entry := range placed {
+		if t.MaxScorers > 0 && teamCount[entry.Team] >= t.MaxScorers {
+			continue
+		}
+		teamCount[entry.Team]++
+		scorers = append(scorers, entry)
+	}
+	points := t.points(e)
+	var ret Scores
+	for i := 0; i < len(scorers); {
+		j := i + 1
+		for j < len(scorers) && scorers[j].Result().PlaceOverall == scorers[i].Result().PlaceOverall {
+			j++
+		}
+		var total float64
+		for k := i; k < j && k < len(points); k++ {
+			total += points[k]
+		}
+		for k := i; k < j; k++ {
+			ret = append(ret, &Score{
+				Event:  e,
+				Entry:  scorers[k],
+				Place:  i + 1,
+				Points: total / float64(j-i),
+			})
+		}
+		i = j
+	}
+	return ret
+}
+
+// Standing is a team's total points and place.
+type Standing struct {
+	Place  int
+	Team   *hytek.HY3SwimTeam
+	Points float64
+}
+
+type Standings []*Standing
+
+// Standings totals the points of the scores that match the filter by team.
+// Teams on the same points share a place.
+func (s Scores) Standings(filter func(*Score) bool) Standings {
+	byTeam := make(map[*hytek.HY3SwimTeam]*Standing)
+	var ret Standings
+	for _, score := range s {
+		if score.Entry.Team == nil || (filter != nil && !filter(score)) {
+			continue
+		}
+		v, ok := byTeam[score.Entry.Team]
+		if !ok {
+			v = &Standing{Team: score.Entry.Team}
+			byTeam[score.Entry.Team] = v
+			ret = append(ret, v)
+		}
+		v.Points += score.Points
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Points == ret[j].Points {
+			return teamName(ret[i].Team) < teamName(ret[j].Team)
+		}
+		return ret[i].Points > ret[j].Points
+	})
+	for i, v := range ret {
+		v.Place = i + 1
+		if i > 0 && v.Points == ret[i-1].Points {
+			v.Place = ret[i-1].Place
+		}
+	}
+	return ret
+}
+
+func teamName(t *hytek.HY3SwimTeam) string {
+	if t.Name == nil {
+		return ""
+	}
+	return t.Name.Name
+}
+
+func (s Scores) Overall() Standings {
+	return s.Standings(nil)
+}
+
+func (s Scores) ByGender() map[hytek.Gender]Standings {
+	ret := make(map[hytek.Gender]Standings)
+	for _, g := range []hytek.Gender{hytek.Female, hytek.Male, hytek.Mixed} {
+		g := g
+		if v := s.Standings(func(s *Score) bool { return s.Gender() == g }); len(v) > 0 {
+			ret[g] = v
+		}
+	}
+	return ret
+}
+
+func (s Scores) ByAgeGroup() map[hytek.AgeGroup]Standings {
+	ret := make(map[hytek.AgeGroup]Standings)
+	for _, a := range s.AgeGroups() {
+		a := a
+		ret[a] = s.Standings(func(s *Score) bool { return s.Event.AgeGroup() == a })
+	}
+	return ret
+}
+
+// AgeGroups returns the age groups of the scores, youngest first.
+func (s Scores) AgeGroups() []hytek.AgeGroup {
+	seen := make(map[hytek.AgeGroup]bool)
+	var ret []hytek.AgeGroup
+	for _, score := range s {
+		a := score.Event.AgeGroup()
+		if !seen[a] {
+			seen[a] = true
+			ret = append(ret, a)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].MinAge == ret[j].MinAge {
+			return ret[i].MaxAge < ret[j].MaxAge
+		}
+		return ret[i].MinAge < ret[j].MinAge
+	})
+	return ret
+}
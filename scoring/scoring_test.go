@@ -0,0 +1,115 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/countcraicula/hytek"
+)
+
+type testPlace struct {
+	team  int
+	place int
+}
+
+type testScore struct {
+	place  int
+	points float64
+}
+
+func TestScore(t *testing.T) {
+	nineSevenSix := []float64{9, 7, 6, 5, 4, 3, 2, 1}
+	tests := []struct {
+		name       string
+		points     []float64
+		maxScorers int
+		places     []testPlace
+		// want is the score of each place, nil for swims that don't score.
+		want []*testScore
+	}{
+		{
+			name:   "points by place",
+			points: nineSevenSix,
+			places: []testPlace{{0, 2}, {1, 1}, {2, 3}, {0, 0}},
+			want:   []*testScore{{2, 7}, {1, 9}, {3, 6}, nil},
+		},
+		{
+			name:   "ties split the points of the places they cover",
+			points: nineSevenSix,
+			places: []testPlace{{0, 1}, {1, 2}, {2, 2}, {0, 4}},
+			want:   []*testScore{{1, 9}, {2, 6.5}, {2, 6.5}, {4, 5}},
+		},
+		{
+			name:   "ties past the end of the table",
+			points: []float64{3, 2, 1},
+			places: []testPlace{{0, 1}, {1, 2}, {2, 3}, {0, 3}},
+			want:   []*testScore{{1, 3}, {2, 2}, {3, 0.5}, {3, 0.5}},
+		},
+		{
+			name:       "team limit skips swimmers",
+			points:     nineSevenSix,
+			maxScorers: 2,
+			places:     []testPlace{{0, 1}, {0, 2}, {0, 3}, {1, 4}},
+			want:       []*testScore{{1, 9}, {2, 7}, nil, {3, 6}},
+		},
+		{
+			name:       "team limit with a tie",
+			points:     nineSevenSix,
+			maxScorers: 1,
+			places:     []testPlace{{0, 1}, {1, 1}, {0, 3}, {2, 4}},
+			want:       []*testScore{{1, 8}, {1, 8}, nil, {3, 6}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			teams := []*hytek.HY3SwimTeam{{}, {}, {}}
+			event := &hytek.Event{Type: hytek.Individual}
+			for _, p := range test.places {
+				event.Entries = append(event.Entries, &hytek.Entry{
+					Team:  teams[p.team],
+					Entry: &hytek.HY3IndividualEventEntryInfo{Result: &hytek.HY3IndividualEventResults{PlaceOverall: p.place}},
+				})
+			}
+			table := &Table{Individual: test.points, MaxScorers: test.maxScorers}
+			got := make(map[*hytek.Entry]*Score)
+			for _, s := range table.Score([]*hytek.Event{event}) {
+				got[s.Entry] = s
+			}
+			for i, want := range test.want {
+				s := got[event.Entries[i]]
+				switch {
+				case want == nil && s != nil:
+					t.Errorf("swim %v scored %v points in place %v, want no score", i, s.Points, s.Place)
+				case want != nil && s == nil:
+					t.Errorf("swim %v didn't score, want %v points in place %v", i, want.points, want.place)
+				case want != nil && (s.Place != want.place || s.Points != want.points):
+					t.Errorf("swim %v scored %v points in place %v, want %v points in place %v", i, s.Points, s.Place, want.points, want.place)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreRelays(t *testing.T) {
+	teams := []*hytek.HY3SwimTeam{{}, {}}
+	event := &hytek.Event{Type: hytek.Relay, Gender: hytek.Mixed}
+	for i, place := range []int{2, 1, 3} {
+		event.Relays = append(event.Relays, &hytek.Entry{
+			Team:       teams[i%2],
+			RelayEntry: &hytek.HY3RelayEventEntryInfo{Result: &hytek.HY3IndividualEventResults{PlaceOverall: place}},
+		})
+	}
+	table := &Table{Individual: []float64{9, 7, 6}, Relay: []float64{18, 14, 12}, MaxScorers: 1}
+	got := make(map[*hytek.Entry]float64)
+	for _, s := range table.Score([]*hytek.Event{event}) {
+		got[s.Entry] = s.Points
+		if s.Gender() != hytek.Mixed {
+			t.Errorf("relay scored for gender %v, want mixed", s.Gender())
+		}
+	}
+	// The third relay is over the team limit.
+	for i, want := range []float64{14, 18, 0} {
+		if p := got[event.Relays[i]]; p != want {
+			t.Errorf("relay %v scored %v points, want %v", i, p, want)
+		}
+	}
+}
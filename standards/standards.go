@@ -0,0 +1,139 @@
+package standards
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+	"github.com/jszwec/csvutil"
+)
+
+// Standard is a qualifying time of a named standard such as "Club" or
+// "National A" for one event and age group.
+type Standard struct {
+	Name     string           `csv:"Standard"`
+	Course   hytek.CourseCode `csv:"Course"`
+	Gender   hytek.Gender     `csv:"Gender"`
+	MinAge   int              `csv:"MinAge"`
+	MaxAge   int              `csv:"MaxAge"`
+	Stroke   hytek.StrokeCode `csv:"Stroke"`
+	Distance int              `csv:"Distance"`
+	Time     hytek.HY3Time    `csv:"Time"`
+}
+
+func (s *Standard) AgeGroup() hytek.AgeGroup {
+	return hytek.AgeGroup{MinAge: s.MinAge, MaxAge: s.MaxAge}
+}
+
+// Set is the time standards swimmers are working towards.
+type Set []*Standard
+
+// ParseSet reads time standards from a CSV file with the columns of
+// Standard. Several standards can share a file, told apart by the Standard
+// column.
+func ParseSet(r io.Reader) (Set, error) {
+	d, err := csvutil.NewDecoder(csv.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	var ret Set
+	if err := d.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Lookup returns the standards of the event for a swimmer of the gender and
+// age, slowest first.
+func (s Set) Lookup(course hytek.CourseCode, gender hytek.Gender, age int, stroke hytek.StrokeCode, distance int) Set {
+	var ret Set
+	for _, v := range s {
+		if v.Course == course && v.Gender == gender && v.Stroke == stroke && v.Distance == distance && v.AgeGroup().Contains(age) {
+			ret = append(ret, v)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].Time > ret[j].Time })
+	return ret
+}
+
+// Achievement is a result that made a standard the swimmer's previous best
+// hadn't.
+type Achievement struct {
+	Standard *Standard
+	Event    *hytek.Event
+	Entry    *hytek.Entry
+	Time     hytek.HY3Time
+	// Previous is the swimmer's best before the meet, zero if there is
+	// none.
+	Previous hytek.HY3Time
+}
+
+// Achievements returns the standards achieved for the first time by the
+// results of the events swum on the date. A standard counts as achieved for
+// the first time when the result is inside it and the swimmer's previous
+// best was not. The previous best comes from the history, falling back on
+// the seed time for swimmers with no earlier swim of the event in it or
+// when h is nil.
+func (s Set) Achievements(course hytek.CourseCode, events []*hytek.Event, h *history.History, date time.Time) []*Achievement {
+	var ret []*Achievement
+	for _, event := range events {
+		for _, entry := range event.Entries {
+			if entry.Swimmer == nil || entry.Entry == nil || !entry.Entry.Result.Placeable() {
+				continue
+			}
+			t := entry.Entry.Result.Time
+			previous := previousBest(course, event, entry, h, date)
+			for _, v := range s.Lookup(course, entry.Swimmer.Gender, entry.Swimmer.Age, event.Stroke, event.Distance) {
+				if t > v.Time || (previous != 0 && previous <= v.Time) {
+					continue
+				}
+				ret = append(ret, &Achievement{
+					Standard: v,
+					Event:    event,
+					Entry:    entry,
+					Time:     t,
+					Previous: previous,
+				})
+			}
+		}
+	}
+	return ret
+}
+
+// previousBest returns the swimmer's best time of the event in the course
+// from before the date, from the history or else the seed time.
+func previousBest(course hytek.CourseCode, event *hytek.Event, entry *hytek.Entry, h *history.History, date time.Time) hytek.HY3Time {
+	if h != nil {
+		best := h.Best(&history.Swim{
+			ID:       strings.TrimSpace(entry.Swimmer.ID),
+			Course:   seedCourses[course],
+			Stroke:   event.Stroke,
+			Distance: event.Distance,
+		}, date)
+		if best != nil {
+			return best.Time
+		}
+	}
+	return seedTime(course, entry.Entry)
+}
+
+// seedCourses are the codes HY3 files use for the course of a seed time.
+var seedCourses = map[hytek.CourseCode]string{
+	hytek.ShortMetres: "S",
+	hytek.ShortYards:  "Y",
+	hytek.LongMeters:  "L",
+}
+
+// seedTime returns the entry's seed time in the meet's course, using the
+// converted seed time when the swimmer entered with a time from another
+// course.
+func seedTime(course hytek.CourseCode, e *hytek.HY3IndividualEventEntryInfo) hytek.HY3Time {
+	if e.SeedCourse1 == "" || e.SeedCourse1 == seedCourses[course] || e.ConversionSeedTime1 == 0 {
+		return e.SeedTime1
+	}
+	return hytek.HY3Time(e.ConversionSeedTime1)
+}
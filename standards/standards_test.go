@@ -0,0 +1,62 @@
+package standards
+
+import (
+	"testing"
+	"time"
+
+	"github.com/countcraicula/hytek"
+	"github.com/countcraicula/hytek/history"
+)
+
+func TestAchievements(t *testing.T) {
+	set := Set{
+		{Name: "Club", Course: hytek.ShortMetres, Gender: hytek.Female, MinAge: 9, MaxAge: 10, Stroke: hytek.Freestyle, Distance: 50, Time: 40},
+		{Name: "County", Course: hytek.ShortMetres, Gender: hytek.Female, MinAge: 9, MaxAge: 10, Stroke: hytek.Freestyle, Distance: 50, Time: 36},
+	}
+	h := history.New()
+	for _, s := range []*history.Swim{
+		{ID: "S1", Date: "2026-09-01", Course: "S", Stroke: hytek.Freestyle, Distance: 50, Time: 39},
+		// Swum after the meet, so not a previous best.
+		{ID: "S1", Date: "2026-11-01", Course: "S", Stroke: hytek.Freestyle, Distance: 50, Time: 34},
+		{ID: "S2", Date: "2026-09-01", Course: "L", Stroke: hytek.Freestyle, Distance: 50, Time: 39},
+	} {
+		if err := h.Add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	date := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		id       string
+		seed     hytek.HY3Time
+		time     hytek.HY3Time
+		h        *history.History
+		want     []string
+		previous hytek.HY3Time
+	}{
+		{name: "history best already inside club", id: "S1", seed: 45, time: 35, h: h, want: []string{"County"}, previous: 39},
+		{name: "no history of the course uses the seed", id: "S2", seed: 45, time: 38, h: h, want: []string{"Club"}, previous: 45},
+		{name: "no history uses the seed", id: "S1", seed: 45, time: 35, want: []string{"Club", "County"}, previous: 45},
+		{name: "no time", id: "S3", time: 41, h: h},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := &hytek.Event{Stroke: hytek.Freestyle, Distance: 50, Entries: hytek.Entries{{
+				Swimmer: &hytek.HY3SwimmerInfo1{ID: test.id, Gender: hytek.Female, Age: 10},
+				Entry: &hytek.HY3IndividualEventEntryInfo{
+					SeedTime1: test.seed,
+					Result:    &hytek.HY3IndividualEventResults{Time: test.time},
+				},
+			}}}
+			got := set.Achievements(hytek.ShortMetres, []*hytek.Event{event}, test.h, date)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v achievements, want %v", len(got), test.want)
+			}
+			for i, a := range got {
+				if a.Standard.Name != test.want[i] || a.Previous != test.previous {
+					t.Errorf("got %v with previous %v, want %v with previous %v", a.Standard.Name, a.Previous, test.want[i], test.previous)
+				}
+			}
+		})
+	}
+}